@@ -0,0 +1,535 @@
+package cityaq
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/requestcache/v3"
+	"github.com/golang/groupcache/lru"
+	"github.com/spatialmodel/inmap"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// metaExtension is the file extension of the files that hold
+// information about cached results.
+const metaExtension = ".meta.json"
+
+// cacheMeta holds information about a cached concentration result.
+type cacheMeta struct {
+	Key          string
+	CityName     string
+	CityID       string
+	SourceType   string
+	Scenario     bool
+	InMAPVersion string
+	Created      time.Time
+
+	// Size is the size of the result in bytes, and Accessed is the
+	// time it was last used, if known. They are not stored with the
+	// rest of the information.
+	Size     int64     `json:"-"`
+	Accessed time.Time `json:"-"`
+}
+
+// newCacheMeta returns information about the result of j.
+func newCacheMeta(j *concentrationJob) cacheMeta {
+	return cacheMeta{
+		Key:          j.Key(),
+		CityName:     j.CityName,
+		CityID:       j.CityID,
+		SourceType:   j.SourceType,
+		Scenario:     j.Scenario != nil,
+		InMAPVersion: inmap.Version,
+		Created:      time.Now(),
+	}
+}
+
+func (m cacheMeta) toRPC() *rpc.CachedResultInfo {
+	o := &rpc.CachedResultInfo{
+		Key:          m.Key,
+		CityName:     m.CityName,
+		CityID:       m.CityID,
+		SourceType:   m.SourceType,
+		Scenario:     m.Scenario,
+		Size:         m.Size,
+		InMAPVersion: m.InMAPVersion,
+	}
+	if !m.Created.IsZero() {
+		o.Created = m.Created.Unix()
+	}
+	return o
+}
+
+// marshal returns the stored form of the receiver, which is the
+// CachedResultInfo message in the protobuf JSON format.
+func (m cacheMeta) marshal() ([]byte, error) {
+	info := m.toRPC()
+	info.Size = 0
+	return protojson.Marshal(info)
+}
+
+// unmarshal sets the fields of the receiver that are present in b,
+// which was created by marshal or, by earlier versions of CityAQ,
+// by encoding/json.
+func (m *cacheMeta) unmarshal(b []byte) error {
+	info := new(rpc.CachedResultInfo)
+	if err := protojson.Unmarshal(b, info); err != nil {
+		return json.Unmarshal(b, m)
+	}
+	m.Key = info.Key
+	m.CityName = info.CityName
+	m.CityID = info.CityID
+	m.SourceType = info.SourceType
+	m.Scenario = info.Scenario
+	m.InMAPVersion = info.InMAPVersion
+	if info.Created != 0 {
+		m.Created = time.Unix(info.Created, 0)
+	}
+	return nil
+}
+
+// cacheStore provides administrative access to the location
+// where results are cached.
+type cacheStore interface {
+	// list returns information about all cached results.
+	list(ctx context.Context) ([]cacheMeta, error)
+
+	// writeMeta stores information about result, which is
+	// about to be cached.
+	writeMeta(ctx context.Context, m cacheMeta, result *inmapResult) error
+
+	// remove deletes the result with the given key and the
+	// information about it.
+	remove(ctx context.Context, key string) error
+}
+
+// memStore keeps track of results that are only cached in memory.
+// Results that have been evicted from the memory cache may still
+// be listed.
+type memStore struct {
+	mu   sync.Mutex
+	meta map[string]cacheMeta
+}
+
+func (s *memStore) list(ctx context.Context) ([]cacheMeta, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o := make([]cacheMeta, 0, len(s.meta))
+	for _, m := range s.meta {
+		o = append(o, m)
+	}
+	return o, nil
+}
+
+func (s *memStore) writeMeta(ctx context.Context, m cacheMeta, result *inmapResult) error {
+	b, err := result.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	m.Size = int64(len(b))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.meta == nil {
+		s.meta = make(map[string]cacheMeta)
+	}
+	s.meta[m.Key] = m
+	return nil
+}
+
+func (s *memStore) remove(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.meta, key)
+	return nil
+}
+
+// diskStore provides access to results cached in a local directory.
+type diskStore struct {
+	dir string
+}
+
+func (s diskStore) list(ctx context.Context) ([]cacheMeta, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("cityaq: listing cache: %w", err)
+	}
+	var o []cacheMeta
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != requestcache.FileExtension {
+			continue
+		}
+		key := strings.TrimSuffix(f.Name(), requestcache.FileExtension)
+		m := cacheMeta{Key: key, Created: f.ModTime(), Accessed: f.ModTime()}
+		if a, err := os.Stat(filepath.Join(s.dir, key+accessExtension)); err == nil {
+			m.Accessed = a.ModTime()
+		}
+		if b, err := ioutil.ReadFile(filepath.Join(s.dir, key+metaExtension)); err == nil {
+			if err := m.unmarshal(b); err != nil {
+				return nil, fmt.Errorf("cityaq: reading cache information for %s: %w", key, err)
+			}
+		}
+		m.Size = f.Size()
+		o = append(o, m)
+	}
+	return o, nil
+}
+
+func (s diskStore) writeMeta(ctx context.Context, m cacheMeta, _ *inmapResult) error {
+	b, err := m.marshal()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(s.dir, m.Key+metaExtension), b, 0644)
+}
+
+func (s diskStore) remove(ctx context.Context, key string) error {
+	for _, ext := range []string{requestcache.FileExtension, metaExtension, accessExtension} {
+		if err := os.Remove(filepath.Join(s.dir, key+ext)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("cityaq: removing cached result: %w", err)
+		}
+	}
+	return nil
+}
+
+// gcsStore provides access to results cached in a Google Cloud
+// Storage bucket.
+type gcsStore struct {
+	bkt    *storage.BucketHandle
+	subdir string
+}
+
+func newGCSStore(ctx context.Context, bucket, subdir string) (*gcsStore, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsStore{bkt: client.Bucket(bucket), subdir: subdir}, nil
+}
+
+func (s *gcsStore) list(ctx context.Context) ([]cacheMeta, error) {
+	it := s.bkt.Objects(ctx, &storage.Query{Prefix: s.subdir + "/"})
+	var o []cacheMeta
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cityaq: listing cache: %w", err)
+		}
+		if !strings.HasSuffix(attrs.Name, requestcache.FileExtension) {
+			continue
+		}
+		key := strings.TrimSuffix(path.Base(attrs.Name), requestcache.FileExtension)
+		m := cacheMeta{Key: key, Created: attrs.Created}
+		r, err := s.bkt.Object(s.subdir + "/" + key + metaExtension).NewReader(ctx)
+		if err == nil {
+			var b []byte
+			b, err = ioutil.ReadAll(r)
+			r.Close()
+			if err == nil {
+				err = m.unmarshal(b)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("cityaq: reading cache information for %s: %w", key, err)
+			}
+		} else if err != storage.ErrObjectNotExist {
+			return nil, fmt.Errorf("cityaq: reading cache information for %s: %w", key, err)
+		}
+		m.Size = attrs.Size
+		o = append(o, m)
+	}
+	return o, nil
+}
+
+func (s *gcsStore) writeMeta(ctx context.Context, m cacheMeta, _ *inmapResult) error {
+	b, err := m.marshal()
+	if err != nil {
+		return err
+	}
+	w := s.bkt.Object(s.subdir + "/" + m.Key + metaExtension).NewWriter(ctx)
+	if _, err := w.Write(b); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *gcsStore) remove(ctx context.Context, key string) error {
+	for _, ext := range []string{requestcache.FileExtension, metaExtension} {
+		err := s.bkt.Object(s.subdir + "/" + key + ext).Delete(ctx)
+		if err != nil && err != storage.ErrObjectNotExist {
+			return fmt.Errorf("cityaq: removing cached result: %w", err)
+		}
+	}
+	return nil
+}
+
+// memoryResults holds the most recently used concentration results in
+// memory. Unlike requestcache.Memory, it allows results to be removed
+// without affecting requests that are in progress.
+type memoryResults struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+}
+
+// newMemoryResults returns a memoryResults that holds up to
+// maxEntries results.
+func newMemoryResults(maxEntries int) *memoryResults {
+	return &memoryResults{cache: lru.New(maxEntries)}
+}
+
+// get returns the result with the given key, if it is held.
+func (m *memoryResults) get(key string) (*inmapResult, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	// Copy the result so that the caller can replace its fields.
+	o := *r.(*inmapResult)
+	return &o, true
+}
+
+// contains returns whether the result with the given key is held.
+func (m *memoryResults) contains(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.cache.Get(key)
+	return ok
+}
+
+// add holds result under the given key.
+func (m *memoryResults) add(key string, result *inmapResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := *result
+	m.cache.Add(key, &r)
+}
+
+// remove discards the result with the given key, so that it is not
+// reused after it has been removed from the store.
+func (m *memoryResults) remove(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache.Remove(key)
+}
+
+// removeCachedResult deletes the cached concentration result with the
+// given key from the store and from memory.
+func (c *CityAQ) removeCachedResult(ctx context.Context, key string) error {
+	if err := c.store.remove(ctx, key); err != nil {
+		return err
+	}
+	c.memResults.remove(key)
+	return nil
+}
+
+// cachedResults returns information about the cached concentration
+// results whose fields match those that are not empty in filter,
+// sorted by key. The CityName field of filter may hold either the
+// name or the ID of a city.
+func (c *CityAQ) cachedResults(ctx context.Context, filter cacheMeta) ([]cacheMeta, error) {
+	if err := c.setupCache(); err != nil {
+		return nil, err
+	}
+	all, err := c.store.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cities := map[string]bool{filter.CityName: true}
+	if filter.CityName != "" {
+		// The city may no longer exist, in which case only
+		// results with the same name or ID match.
+		if cf, err := c.cityFile(filter.CityName); err == nil {
+			cities[cf.name], cities[cf.id] = true, true
+		}
+	}
+	var o []cacheMeta
+	for _, m := range all {
+		if (filter.Key == "" || m.Key == filter.Key) &&
+			(filter.CityName == "" || cities[m.CityName] || (m.CityID != "" && cities[m.CityID])) &&
+			(filter.SourceType == "" || m.SourceType == filter.SourceType) {
+			o = append(o, m)
+		}
+	}
+	sort.Slice(o, func(i, j int) bool { return o[i].Key < o[j].Key })
+	return o, nil
+}
+
+// invalidateCachedResults deletes the cached concentration results
+// whose fields match those that are not empty in filter and returns
+// their keys.
+func (c *CityAQ) invalidateCachedResults(ctx context.Context, filter cacheMeta) ([]string, error) {
+	if filter.Key == "" && filter.CityName == "" && filter.SourceType == "" {
+		return nil, invalidArgument("Key", "cityaq: a key, city name, or source type must be specified to invalidate cached results")
+	}
+	results, err := c.cachedResults(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, m := range results {
+		if err := c.removeCachedResult(ctx, m.Key); err != nil {
+			return keys, err
+		}
+		keys = append(keys, m.Key)
+	}
+	return keys, nil
+}
+
+// checkAdmin returns an error if ctx does not hold a bearer token
+// matching the AdminToken field of the receiver in its
+// "authorization" metadata.
+func (c *CityAQ) checkAdmin(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var auth string
+	if v := md.Get("authorization"); len(v) > 0 {
+		auth = v[0]
+	}
+	return c.checkAdminToken(auth)
+}
+
+// checkAdminToken returns an error if the given authorization
+// header value does not hold the administrator bearer token.
+func (c *CityAQ) checkAdminToken(auth string) error {
+	if c.AdminToken == "" {
+		return status.Error(codes.PermissionDenied, "cityaq: administration is disabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+c.AdminToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "cityaq: not authorized")
+	}
+	return nil
+}
+
+// ListCachedResults returns information about the cached concentration
+// results for the city and source type in req, if specified.
+func (c *CityAQ) ListCachedResults(ctx context.Context, req *rpc.ListCachedResultsRequest) (*rpc.ListCachedResultsResponse, error) {
+	if err := c.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	results, err := c.cachedResults(ctx, cacheMeta{CityName: req.CityName, SourceType: req.SourceType})
+	if err != nil {
+		return nil, err
+	}
+	o := new(rpc.ListCachedResultsResponse)
+	for _, m := range results {
+		o.Results = append(o.Results, m.toRPC())
+	}
+	return o, nil
+}
+
+// CachedResult returns information about the cached concentration
+// result with the key in req.
+func (c *CityAQ) CachedResult(ctx context.Context, req *rpc.CachedResultRequest) (*rpc.CachedResultInfo, error) {
+	if err := c.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return c.cachedResult(ctx, req.Key)
+}
+
+func (c *CityAQ) cachedResult(ctx context.Context, key string) (*rpc.CachedResultInfo, error) {
+	if key == "" {
+		return nil, invalidArgument("Key", "cityaq: cache key must be specified")
+	}
+	results, err := c.cachedResults(ctx, cacheMeta{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, notFound("Key", "cached result", key, "cityaq: cached result %s not found", key)
+	}
+	return results[0].toRPC(), nil
+}
+
+// InvalidateCachedResults deletes the cached concentration results
+// that match req.
+func (c *CityAQ) InvalidateCachedResults(ctx context.Context, req *rpc.InvalidateCachedResultsRequest) (*rpc.InvalidateCachedResultsResponse, error) {
+	if err := c.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	keys, err := c.invalidateCachedResults(ctx, cacheMeta{
+		Key:        req.Key,
+		CityName:   req.CityName,
+		SourceType: req.SourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.InvalidateCachedResultsResponse{Keys: keys}, nil
+}
+
+// cacheAdminHandler serves cache administration requests over HTTP.
+// GET requests list the cached results matching the "city" and "source"
+// query parameters, or return the result matching the "key" parameter.
+// DELETE requests invalidate the results matching the same parameters.
+// Requests must have an "Authorization: Bearer <AdminToken>" header.
+type cacheAdminHandler struct {
+	c *CityAQ
+}
+
+func (h cacheAdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.c.checkAdminToken(r.Header.Get("Authorization")); err != nil {
+		writeRESTError(w, httpStatus(err), err)
+		return
+	}
+	q := r.URL.Query()
+	filter := cacheMeta{
+		Key:        q.Get("key"),
+		CityName:   q.Get("city"),
+		SourceType: q.Get("source"),
+	}
+	var o proto.Message
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		if filter.Key != "" {
+			o, err = h.c.cachedResult(r.Context(), filter.Key)
+			break
+		}
+		var results []cacheMeta
+		results, err = h.c.cachedResults(r.Context(), filter)
+		resp := new(rpc.ListCachedResultsResponse)
+		for _, m := range results {
+			resp.Results = append(resp.Results, m.toRPC())
+		}
+		o = resp
+	case http.MethodDelete:
+		var keys []string
+		keys, err = h.c.invalidateCachedResults(r.Context(), filter)
+		o = &rpc.InvalidateCachedResultsResponse{Keys: keys}
+	default:
+		writeRESTError(w, http.StatusMethodNotAllowed, fmt.Errorf("cityaq: method %s not allowed", r.Method))
+		return
+	}
+	if err != nil {
+		writeRESTError(w, httpStatus(err), err)
+		return
+	}
+	b, err := protojson.Marshal(o)
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
@@ -0,0 +1,262 @@
+package cityaq
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"github.com/spatialmodel/inmap/inmaputil"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestCityAQ_CachedResults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		CacheLoc:    "file://" + dir,
+		AdminToken:  "secret",
+	}
+	if err := c.setupCache(); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []cacheMeta{
+		{Key: "concentrationa", CityName: "Accra Metropolitan", CityID: "accra_jurisdiction", SourceType: "roadways"},
+		{Key: "concentrationb", CityName: "Accra Metropolitan", SourceType: "railways"},
+		{Key: "concentrationc", CityName: "Karachi", SourceType: "roadways"},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, m.Key+".dat"), []byte("data"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.store.writeMeta(context.Background(), m, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := c.ListCachedResults(context.Background(), &rpc.ListCachedResultsRequest{}); err == nil {
+		t.Error("expected an error without a token")
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+
+	list, err := c.ListCachedResults(ctx, &rpc.ListCachedResultsRequest{CityName: "Accra Metropolitan"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, r := range list.Results {
+		keys = append(keys, r.Key)
+		if r.Size != 4 {
+			t.Errorf("%s: size %d != 4", r.Key, r.Size)
+		}
+	}
+	if want := []string{"concentrationa", "concentrationb"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("list: %v != %v", keys, want)
+	}
+
+	list, err = c.ListCachedResults(ctx, &rpc.ListCachedResultsRequest{CityName: "accra_jurisdiction"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Results) != 2 {
+		t.Errorf("list by ID: have %d results, want 2", len(list.Results))
+	} else if r := list.Results[0]; r.CityID != "accra_jurisdiction" || r.CityName != "Accra Metropolitan" {
+		t.Errorf("wrong result: %+v", r)
+	}
+
+	info, err := c.CachedResult(ctx, &rpc.CachedResultRequest{Key: "concentrationc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.CityName != "Karachi" || info.SourceType != "roadways" {
+		t.Errorf("wrong result: %+v", info)
+	}
+
+	if _, err := c.InvalidateCachedResults(ctx, &rpc.InvalidateCachedResultsRequest{}); err == nil {
+		t.Error("expected an error for an empty request")
+	}
+	inv, err := c.InvalidateCachedResults(ctx, &rpc.InvalidateCachedResultsRequest{SourceType: "roadways"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"concentrationa", "concentrationc"}; !reflect.DeepEqual(inv.Keys, want) {
+		t.Errorf("invalidate: %v != %v", inv.Keys, want)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		filepath.Join(dir, "concentrationb.dat"),
+		filepath.Join(dir, "concentrationb.meta.json"),
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("remaining files: %v != %v", files, want)
+	}
+}
+
+func TestCacheAdminHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "concentrationa.dat"), []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name, token, method string
+		code                int
+	}{
+		{name: "disabled", method: http.MethodGet, code: http.StatusForbidden},
+		{name: "wrong token", token: "wrong", method: http.MethodGet, code: http.StatusUnauthorized},
+		{name: "list", token: "secret", method: http.MethodGet, code: http.StatusOK},
+		{name: "not allowed", token: "secret", method: http.MethodPost, code: http.StatusMethodNotAllowed},
+		{name: "invalidate", token: "secret", method: http.MethodDelete, code: http.StatusOK},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := &CityAQ{CacheLoc: "file://" + dir}
+			if test.name != "disabled" {
+				c.AdminToken = "secret"
+			}
+			r := httptest.NewRequest(test.method, "/admin/cache?key=concentrationa", nil)
+			r.Header.Set("Authorization", "Bearer "+test.token)
+			w := httptest.NewRecorder()
+			cacheAdminHandler{c: c}.ServeHTTP(w, r)
+			if w.Code != test.code {
+				t.Fatalf("status %d != %d: %s", w.Code, test.code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+			switch test.method {
+			case http.MethodGet:
+				info := new(rpc.CachedResultInfo)
+				if err := protojson.Unmarshal(w.Body.Bytes(), info); err != nil {
+					t.Fatal(err)
+				}
+				if info.Key != "concentrationa" || info.Size != 4 {
+					t.Errorf("wrong result: %+v", info)
+				}
+			case http.MethodDelete:
+				if _, err := os.Stat(filepath.Join(dir, "concentrationa.dat")); !os.IsNotExist(err) {
+					t.Error("result was not deleted")
+				}
+			}
+		})
+	}
+}
+
+func TestCacheMeta_unmarshal(t *testing.T) {
+	created := time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	want := cacheMeta{
+		Key:          "concentrationa",
+		CityName:     "Accra Metropolitan",
+		CityID:       "accra_jurisdiction",
+		SourceType:   "roadways",
+		InMAPVersion: "1.7.0",
+		Created:      created,
+	}
+	b, err := want.marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var have cacheMeta
+	if err := have.unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	have.Created = have.Created.UTC()
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("have %+v, want %+v", have, want)
+	}
+
+	// Information stored by earlier versions.
+	legacy := []byte(`{"Key":"concentrationa","CityName":"Accra Metropolitan","SourceType":"roadways","Scenario":false,"InMAPVersion":"1.7.0","Created":"2020-07-01T00:00:00Z"}`)
+	have = cacheMeta{}
+	if err := have.unmarshal(legacy); err != nil {
+		t.Fatal(err)
+	}
+	want.CityID = ""
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("legacy: have %+v, want %+v", have, want)
+	}
+}
+
+// gatedModel is a linearModel that counts its runs and waits for
+// release to be closed before running.
+type gatedModel struct {
+	runs    *int32
+	release chan struct{}
+}
+
+func (m gatedModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	atomic.AddInt32(m.runs, 1)
+	<-m.release
+	return linearModel{}.run(ctx, name, cfg, result, progress)
+}
+
+func TestCityAQ_removeCachedResult_inProgress(t *testing.T) {
+	m := gatedModel{runs: new(int32), release: make(chan struct{})}
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           m,
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	req := &rpc.GriddedConcentrationsRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Emission:   rpc.Emission_PM2_5,
+	}
+	errc := make(chan error)
+	request := func() {
+		_, err := c.GriddedConcentrations(context.Background(), req)
+		errc <- err
+	}
+
+	go request()
+	for atomic.LoadInt32(m.runs) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.removeCachedResult(context.Background(), job.Key()); err != nil {
+		t.Fatal(err)
+	}
+	go request()
+	time.Sleep(50 * time.Millisecond)
+	close(m.release)
+	for i := 0; i < 2; i++ {
+		if err := <-errc; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if runs := atomic.LoadInt32(m.runs); runs != 1 {
+		t.Errorf("model was run %d times, want 1", runs)
+	}
+}
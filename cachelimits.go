@@ -0,0 +1,113 @@
+package cityaq
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ctessum/requestcache/v3"
+)
+
+// cacheCleanupInterval is the minimum amount of time between checks
+// of whether the cache exceeds the limits set by MaxCacheSize and
+// CacheTTL.
+const cacheCleanupInterval = time.Minute
+
+// accessExtension is the file extension of the files whose
+// modification times record when cached results were last used. They
+// are kept separate from the results so that the modification times
+// of results without information files are their creation times.
+const accessExtension = ".accessed"
+
+// accessRecorder is implemented by cacheStores that can record when
+// a result was last used.
+type accessRecorder interface {
+	// touch records that the result with the given key was used.
+	touch(key string) error
+}
+
+func (s diskStore) touch(key string) error {
+	if _, err := os.Stat(filepath.Join(s.dir, key+requestcache.FileExtension)); os.IsNotExist(err) {
+		return nil
+	}
+	return ioutil.WriteFile(filepath.Join(s.dir, key+accessExtension), nil, 0644)
+}
+
+// lastUsed returns the last time the result was used, if
+// known, or otherwise the time it was created.
+func (m cacheMeta) lastUsed() time.Time {
+	if m.Accessed.After(m.Created) {
+		return m.Accessed
+	}
+	return m.Created
+}
+
+// recordAccess records that the result with the given key was used,
+// so that it is not evicted before results that were used less
+// recently.
+func (c *CityAQ) recordAccess(key string) {
+	if s, ok := c.store.(accessRecorder); ok {
+		if err := s.touch(key); err != nil {
+			log.Printf("cityaq: recording cache access: %v", err)
+		}
+	}
+}
+
+// checkCacheLimits calls enforceCacheLimits if it hasn't been called
+// within the last cacheCleanupInterval, logging any errors.
+func (c *CityAQ) checkCacheLimits(ctx context.Context) {
+	if c.MaxCacheSize <= 0 && c.CacheTTL <= 0 {
+		return
+	}
+	if _, ok := c.store.(*memStore); ok {
+		// The memory cache has its own limit.
+		return
+	}
+	c.cacheCleanupMu.Lock()
+	if time.Since(c.lastCacheCleanup) < cacheCleanupInterval {
+		c.cacheCleanupMu.Unlock()
+		return
+	}
+	c.lastCacheCleanup = time.Now()
+	c.cacheCleanupMu.Unlock()
+
+	if _, err := c.enforceCacheLimits(ctx); err != nil {
+		log.Printf("cityaq: enforcing cache limits: %v", err)
+	}
+}
+
+// enforceCacheLimits removes cached results that were created longer
+// ago than CacheTTL, and then removes the least recently used results
+// until their total size is no more than MaxCacheSize. It returns
+// the keys of the removed results.
+func (c *CityAQ) enforceCacheLimits(ctx context.Context) ([]string, error) {
+	results, err := c.cachedResults(ctx, cacheMeta{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].lastUsed().Before(results[j].lastUsed())
+	})
+	var size int64
+	for _, m := range results {
+		size += m.Size
+	}
+	var removed []string
+	for _, m := range results {
+		expired := c.CacheTTL > 0 && time.Since(m.Created) > c.CacheTTL
+		tooBig := c.MaxCacheSize > 0 && size > c.MaxCacheSize
+		if !expired && !tooBig {
+			continue
+		}
+		if err := c.removeCachedResult(ctx, m.Key); err != nil {
+			return removed, err
+		}
+		size -= m.Size
+		removed = append(removed, m.Key)
+	}
+	return removed, nil
+}
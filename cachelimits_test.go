@@ -0,0 +1,141 @@
+package cityaq
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCityAQ_enforceCacheLimits(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		maxSize int64
+		ttl     time.Duration
+		removed []string
+	}{
+		{name: "none"},
+		{name: "size", maxSize: 15, removed: []string{"concentrationb", "concentrationa"}},
+		{name: "ttl", ttl: 90 * time.Minute, removed: []string{"concentrationa"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "cityaq_cache")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			c := &CityAQ{
+				CacheLoc:     "file://" + dir,
+				MaxCacheSize: test.maxSize,
+				CacheTTL:     test.ttl,
+			}
+			if err := c.setupCache(); err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now()
+			for _, r := range []struct {
+				key     string
+				size    int
+				used    time.Time
+				created time.Time
+			}{
+				{key: "concentrationa", size: 10, used: now.Add(-time.Hour), created: now.Add(-2 * time.Hour)},
+				{key: "concentrationb", size: 10, used: now.Add(-75 * time.Minute), created: now.Add(-80 * time.Minute)},
+				{key: "concentrationc", size: 10, used: now, created: now},
+			} {
+				file := filepath.Join(dir, r.key+".dat")
+				if err := ioutil.WriteFile(file, make([]byte, r.size), 0644); err != nil {
+					t.Fatal(err)
+				}
+				if err := c.store.writeMeta(context.Background(), cacheMeta{Key: r.key, Created: r.created}, nil); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chtimes(file, r.used, r.used); err != nil {
+					t.Fatal(err)
+				}
+				c.memResults.add(r.key, new(inmapResult))
+			}
+
+			removed, err := c.enforceCacheLimits(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(removed, test.removed) {
+				t.Errorf("removed %v, want %v", removed, test.removed)
+			}
+			results, err := c.cachedResults(context.Background(), cacheMeta{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 3-len(test.removed) {
+				t.Errorf("%d results remain, want %d", len(results), 3-len(test.removed))
+			}
+			for _, m := range results {
+				if _, ok := c.memResults.get(m.Key); !ok {
+					t.Errorf("%s was removed from memory", m.Key)
+				}
+			}
+			for _, key := range removed {
+				if _, ok := c.memResults.get(key); ok {
+					t.Errorf("%s was not removed from memory", key)
+				}
+			}
+		})
+	}
+}
+
+func TestCityAQ_enforceCacheLimits_accessed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &CityAQ{
+		CacheLoc: "file://" + dir,
+		CacheTTL: 90 * time.Minute,
+	}
+	if err := c.setupCache(); err != nil {
+		t.Fatal(err)
+	}
+	// The result has no information file, so its creation time is
+	// the modification time of the result file.
+	const key = "concentrationa"
+	file := filepath.Join(dir, key+".dat")
+	if err := ioutil.WriteFile(file, make([]byte, 10), 0644); err != nil {
+		t.Fatal(err)
+	}
+	created := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(file, created, created); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		c.recordAccess(key)
+	}
+
+	results, err := c.cachedResults(context.Background(), cacheMeta{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || !results[0].Created.Equal(created) || time.Since(results[0].lastUsed()) > time.Minute {
+		t.Fatalf("invalid results %+v", results)
+	}
+	removed, err := c.enforceCacheLimits(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(removed, []string{key}) {
+		t.Errorf("removed %v, want [%s]", removed, key)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("%d files remain", len(files))
+	}
+}
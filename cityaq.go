@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"math"
 	"net/url"
 	"os"
-	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
 	"github.com/ctessum/geom"
@@ -29,83 +30,278 @@ type CityAQ struct {
 
 	aeputil.SpatialConfig
 
-	// Location where temporary results should be stored.
-	CacheLoc    string
+	// Location where temporary results should be stored: either a
+	// local directory, optionally prefixed with file://, a Google Cloud
+	// Storage location (gs://bucket/path), or an S3-compatible storage
+	// location (s3://bucket/path). If it is empty, results are only
+	// stored in memory. It is also used to store the output of
+	// InMAP jobs run by CloudBackend.
+	CacheLoc string
+
+	// MaxCacheSize is the maximum total size in bytes of the results
+	// stored in CacheLoc. When it is exceeded, the least recently used
+	// results are removed; for gs:// and s3:// locations, where use
+	// isn't tracked, the oldest results are removed instead. If it is
+	// zero, there is no limit.
+	MaxCacheSize int64
+
+	// CacheTTL is the amount of time after which results stored in
+	// CacheLoc are removed. If it is zero, results do not expire.
+	CacheTTL time.Duration
+
+	// S3 specifies how to access storage when CacheLoc is an s3://
+	// location. With the cloud backend, the region and credentials
+	// are passed to the InMAP cloud client through the AWS_REGION,
+	// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment
+	// variables. The cloud client always uses the AWS S3 endpoint,
+	// so other endpoints can only be used with the other backends.
+	S3 S3Config
+
+	// MigrateLegacyCacheKeys specifies whether results in CacheLoc that
+	// were stored under the cache keys used by earlier versions of
+	// CityAQ, which only depend on the city name and source type, should
+	// be reused. It should only be set if the city boundaries, surrogate
+	// specifications, and InMAP version and configuration have not
+	// changed since the results were created.
+	MigrateLegacyCacheKeys bool
+
 	inmapClient *cloud.Client
 
 	// InMAPConfigFile specifies the path to the file with InMAP
 	// configuration information.
 	InMAPConfigFile string
 
-	// cityPaths holds the locations of the files containing the
+	// Backend specifies how InMAP simulations are run: either
+	// CloudBackend (the default), LocalBackend, or SRBackend.
+	Backend string
+
+	// SRMatrixFile specifies the path to the InMAP source-receptor
+	// matrix NetCDF file used by SRBackend.
+	SRMatrixFile string
+
+	// JobPollInterval is the initial interval between checks of the
+	// status of InMAP jobs run by CloudBackend. The interval doubles
+	// after each check, up to MaxJobPollInterval. The defaults are
+	// 1 minute and 10 minutes, respectively.
+	JobPollInterval, MaxJobPollInterval time.Duration
+
+	// MaxJobDuration is the maximum amount of time to wait for an
+	// InMAP job run by CloudBackend to finish. If it is zero, there
+	// is no limit.
+	MaxJobDuration time.Duration
+
+	// JobRetries is the number of times an InMAP job run by
+	// CloudBackend is resubmitted after it fails.
+	JobRetries int
+
+	// JobRegistryFile is the location of the file where running jobs
+	// are saved so that they can be resumed by ResumeJobs after the
+	// server restarts. By default, it is a file in CacheLoc if
+	// CacheLoc is a local directory. Otherwise, including when CacheLoc
+	// is in Google Cloud Storage or S3, jobs are only saved if it is
+	// set, and a warning is logged when the model is set up.
+	JobRegistryFile string
+
+	// CountryIncomeFile is the location of a CSV file with the
+	// per-capita income of each country, in US dollars. It has a header
+	// row and columns for the country name, matching the names in
+	// Countries_WGS84.shp, and the income. Damages uses it to adjust the
+	// value of a statistical life for the countries that cities are in,
+	// relative to ReferenceCountry, unless requests specify the incomes.
+	// If it is empty, no income data is loaded, and requests that adjust
+	// the value of a statistical life must provide the incomes.
+	CountryIncomeFile string
+
+	// ReferenceCountry is the country in CountryIncomeFile whose income
+	// is used as the reference income when adjusting the value of a
+	// statistical life. By default, it is "United States".
+	ReferenceCountry string
+
+	// AdminToken is the bearer token that clients must provide to use
+	// the cache administration RPCs and the /admin/cache HTTP route.
+	// If it is empty, cache administration is disabled.
+	AdminToken string
+
+	// cities holds the locations of the files containing the
 	// boundaries of each city.
-	cityPaths         map[string]string
-	loadCityPathsOnce sync.Once
+	cities         *cityRegistry
+	loadCitiesOnce retryOnce
+
+	// cityInfos holds the results of cityInfo, keyed by city name and
+	// boundary version.
+	cityInfoMu sync.Mutex
+	cityInfos  map[string]*rpc.City
 
 	countries         *rtree.Rtree
-	loadCountriesOnce sync.Once
-	cloudSetupOnce    sync.Once
+	loadCountriesOnce retryOnce
+
+	// countryIncomes holds the contents of CountryIncomeFile,
+	// keyed by country name.
+	countryIncomes         map[string]float64
+	loadCountryIncomesOnce retryOnce
 
-	cacheSetupOnce sync.Once
+	model          concentrationModel
+	modelSetupOnce retryOnce
+	jobs           jobTracker
+
+	cacheSetupOnce retryOnce
 	cache          *requestcache.Cache
+
+	// memResults holds recently used results in memory.
+	memResults *memoryResults
+
+	// storeFunc caches results in the location specified by
+	// CacheLoc, if any, and store provides administrative
+	// access to the cached results.
+	storeFunc requestcache.CacheFunc
+	store     cacheStore
+
+	cacheCleanupMu   sync.Mutex
+	lastCacheCleanup time.Time
+
+	// legacyCache holds results stored using keys from
+	// before keyVersion 2.
+	legacyCache *requestcache.Cache
 }
 
-// Cities returns the files in the CityGeomDir directory field of the receiver.
-func (c *CityAQ) Cities(ctx context.Context, _ *rpc.CitiesRequest) (*rpc.CitiesResponse, error) {
-	c.cityPaths = make(map[string]string)
-	r := new(rpc.CitiesResponse)
-	err := filepath.Walk(os.ExpandEnv(c.CityGeomDir), func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+// Cities returns the cities in the files in the CityGeomDir directory
+// field of the receiver, with names in the language requested by req.
+// Files that are added, changed or removed are detected automatically.
+func (c *CityAQ) Cities(ctx context.Context, req *rpc.CitiesRequest) (*rpc.CitiesResponse, error) {
+	if err := c.loadCities(); err != nil {
+		return nil, err
+	}
+	if !c.cities.watching() {
+		// Changes can't be detected, so check for them now.
+		if err := c.cities.load(); err != nil {
+			return nil, dependencyUnavailable(dependencyCities, err)
+		}
+	}
+	o := new(rpc.CitiesResponse)
+	for _, f := range c.cities.list() {
+		info, err := c.cityInfo(f)
+		if os.IsNotExist(err) {
+			// The file has been removed since the cities were
+			// last loaded.
+			continue
+		} else if err != nil {
+			// The file has been changed since the cities were last
+			// loaded, and it will be skipped when they are reloaded.
+			log.Printf("cityaq: skipping city %s: %v", f.name, err)
+			continue
 		}
-		if info.IsDir() || filepath.Ext(path) != ".geojson" {
-			return nil
+		name := info.Name
+		if req.GetLanguage() != "" {
+			name = localizedName(info.DisplayNames, req.GetLanguage(), info.Name)
 		}
-		name, err := c.geojsonName(path, "en")
+		o.Names = append(o.Names, name)
+		o.Cities = append(o.Cities, info)
+	}
+	return o, nil
+}
+
+// loadCities finds the city boundary files and starts watching for
+// changes to them, if that hasn't been done already.
+func (c *CityAQ) loadCities() error {
+	return c.loadCitiesOnce.Do(func() error {
+		r, err := newCityRegistry(os.ExpandEnv(c.CityGeomDir), func(path string) (string, error) {
+			return c.geojsonName(path, "")
+		})
 		if err != nil {
-			return err
+			return dependencyUnavailable(dependencyCities, err)
+		}
+		if err := r.watch(); err != nil {
+			log.Printf("cityaq: not watching %s for changes: %v", r.dir, err)
 		}
-		r.Names = append(r.Names, name)
-		c.cityPaths[name] = path
+		c.cities = r
 		return nil
 	})
-	return r, err
 }
 
-func (c *CityAQ) loadCityPaths() {
-	c.loadCityPathsOnce.Do(func() {
-		if c.cityPaths == nil {
-			_, err := c.Cities(context.Background(), nil)
-			if err != nil {
-				panic(err)
+// cityFile returns the file that contains the boundary of the city with
+// the given name or ID.
+func (c *CityAQ) cityFile(cityName string) (cityFile, error) {
+	if err := c.loadCities(); err != nil {
+		return cityFile{}, err
+	}
+	f, ok := c.cities.get(cityName)
+	if !ok {
+		return cityFile{}, notFound("CityName", "city", cityName, "cityaq: invalid city name %s", cityName)
+	}
+	return f, nil
+}
+
+// setupCache initializes the cache of concentration results, if it
+// hasn't been initialized already.
+func (c *CityAQ) setupCache() error {
+	return c.cacheSetupOnce.Do(func() error {
+		storeFunc, store, err := c.cacheStores()
+		if err != nil {
+			return dependencyUnavailable(dependencyCache, err)
+		}
+		c.storeFunc, c.store = storeFunc, store
+		c.cache = c.newResultCache()
+		c.memResults = newMemoryResults(20)
+		if c.MigrateLegacyCacheKeys && c.CacheLoc != "" {
+			if c.storeFunc != nil {
+				c.legacyCache = requestcache.NewCache(runtime.GOMAXPROCS(-1), c.storeFunc)
+			} else {
+				c.legacyCache = requestcache.NewCache(runtime.GOMAXPROCS(-1))
 			}
 		}
+		return nil
 	})
 }
 
-func (c *CityAQ) setupCache() {
-	c.cacheSetupOnce.Do(func() {
-		workers := runtime.GOMAXPROCS(-1)
-		d := requestcache.Deduplicate()
-		m := requestcache.Memory(20)
-		if c.CacheLoc == "" {
-			c.cache = requestcache.NewCache(workers, d, m)
-		} else if strings.HasPrefix(c.CacheLoc, "gs://") {
-			loc, err := url.Parse(c.CacheLoc)
-			if err != nil {
-				panic(err)
-			}
-			cf, err := requestcache.GoogleCloudStorage(context.TODO(), loc.Host,
-				strings.TrimLeft(loc.Path, "/"))
-			if err != nil {
-				panic(err)
-			}
-			c.cache = requestcache.NewCache(workers, d, m, cf)
-		} else {
-			c.cache = requestcache.NewCache(workers, d, m,
-				requestcache.Disk(strings.TrimPrefix(c.CacheLoc, "file://")))
+// cacheStores returns the requestcache function and the cacheStore for
+// the location specified by CacheLoc.
+func (c *CityAQ) cacheStores() (requestcache.CacheFunc, cacheStore, error) {
+	switch {
+	case c.CacheLoc == "":
+		return nil, new(memStore), nil
+	case strings.HasPrefix(c.CacheLoc, "gs://"):
+		loc, err := url.Parse(c.CacheLoc)
+		if err != nil {
+			return nil, nil, err
 		}
-	})
+		subdir := strings.TrimLeft(loc.Path, "/")
+		storeFunc, err := requestcache.GoogleCloudStorage(context.TODO(), loc.Host, subdir)
+		if err != nil {
+			return nil, nil, err
+		}
+		store, err := newGCSStore(context.TODO(), loc.Host, subdir)
+		if err != nil {
+			return nil, nil, err
+		}
+		return storeFunc, store, nil
+	case strings.HasPrefix(c.CacheLoc, "s3://"):
+		loc, err := url.Parse(c.CacheLoc)
+		if err != nil {
+			return nil, nil, err
+		}
+		// requestcache doesn't support S3, so results are
+		// stored by wrapping jobs using storedJob.
+		store, err := newS3Store(loc, c.S3)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, store, nil
+	default:
+		dir := strings.TrimPrefix(c.CacheLoc, "file://")
+		return requestcache.Disk(dir), diskStore{dir: dir}, nil
+	}
+}
+
+// newResultCache returns a new cache for concentration results, which
+// are stored in the location specified by CacheLoc. Results are held
+// in memory separately, by memResults, so that they can be removed.
+func (c *CityAQ) newResultCache() *requestcache.Cache {
+	workers := runtime.GOMAXPROCS(-1)
+	d := requestcache.Deduplicate()
+	if c.storeFunc == nil {
+		return requestcache.NewCache(workers, d)
+	}
+	return requestcache.NewCache(workers, d, c.storeFunc)
 }
 
 // CityGeometry returns the geometry of the requested city.
@@ -152,8 +348,17 @@ func polygonsToRPC(polys []geom.Polygon) []*rpc.Polygon {
 	return o
 }
 
-// geojsonGeometry returns the geometry of the requested geojson file.
+// geojsonGeometry returns the geometry of the requested city.
 func (c *CityAQ) geojsonGeometry(cityName string) (geom.Polygon, error) {
+	cf, err := c.cityFile(cityName)
+	if err != nil {
+		return nil, err
+	}
+	return geojsonFileGeometry(cf.path)
+}
+
+// geojsonFileGeometry returns the geometry of the given geojson file.
+func geojsonFileGeometry(path string) (geom.Polygon, error) {
 	type gj struct {
 		Type     string `json:"type"`
 		Features []struct {
@@ -162,16 +367,11 @@ func (c *CityAQ) geojsonGeometry(cityName string) (geom.Polygon, error) {
 		} `json:"features"`
 	}
 
-	c.loadCityPaths()
-	path, ok := c.cityPaths[cityName]
-	if !ok {
-		return nil, fmt.Errorf("invalid city name %s", cityName)
-	}
-
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("opening city geojson file: %v", err)
 	}
+	defer f.Close()
 	dec := json.NewDecoder(f)
 	var data gj
 	if err := dec.Decode(&data); err != nil {
@@ -196,21 +396,81 @@ func (c *CityAQ) geojsonGeometry(cityName string) (geom.Polygon, error) {
 	return polys, nil
 }
 
-// geojsonName returns a city name (in the requested language)
-// from a GeoJSON file. (Language doesn't currently do anything)
-func (c *CityAQ) geojsonName(path, _ string) (string, error) {
+// geojsonName returns a city name in the requested language from a
+// GeoJSON file, using the name:xx properties where xx is the language.
+// If there is no name in the language, the English name is returned.
+// If language is empty, the name used in requests is returned.
+func (c *CityAQ) geojsonName(path, language string) (string, error) {
+	propmap, err := geojsonProperties(path)
+	if err != nil {
+		return "", err
+	}
+	nameI, ok := propmap["c40_city_name"]
+	if !ok {
+		nameI, ok = propmap["name"]
+		if !ok {
+			return "", failedPrecondition("CITY_FILE", path, "cityaq: file %s, missing name", path)
+		}
+	}
+	name, ok := nameI.(string)
+	if !ok {
+		return "", failedPrecondition("CITY_FILE", path, "cityaq: file %s, name %v is not a string", path, nameI)
+	}
+	if language == "" {
+		return name, nil
+	}
+	return localizedName(displayNames(propmap), language, name), nil
+}
+
+// displayNames returns the names in different languages from the
+// name:xx GeoJSON properties, keyed by language.
+func displayNames(props map[string]interface{}) map[string]string {
+	names := make(map[string]string)
+	for k, v := range props {
+		if lang := strings.TrimPrefix(k, "name:"); lang != k {
+			if name, ok := v.(string); ok && name != "" {
+				names[lang] = name
+			}
+		}
+	}
+	return names
+}
+
+// localizedName returns the name in names for the given language,
+// or the English name, or otherwise defaultName.
+func localizedName(names map[string]string, language, defaultName string) string {
+	if name, ok := names[language]; ok {
+		return name
+	}
+	if name, ok := names["en"]; ok {
+		return name
+	}
+	return defaultName
+}
+
+// geojsonProperties returns the properties of the first feature
+// in a GeoJSON file that has them.
+func geojsonProperties(path string) (map[string]interface{}, error) {
 	type gj interface{}
 
 	f, err := os.Open(path)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	defer f.Close()
 	dec := json.NewDecoder(f)
 	var data gj
 	if err := dec.Decode(&data); err != nil {
-		return "", fmt.Errorf("file %s: %v", path, err)
+		return nil, failedPrecondition("CITY_FILE", path, "cityaq: file %s: %v", path, err)
+	}
+	datamap, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, failedPrecondition("CITY_FILE", path, "cityaq: file %s is not a GeoJSON object", path)
+	}
+	features, ok := datamap["features"].([]interface{})
+	if !ok {
+		return nil, failedPrecondition("CITY_FILE", path, "cityaq: file %s has no features", path)
 	}
-	features := data.(map[string]interface{})["features"].([]interface{})
 	for _, feat := range features {
 		featmap, ok := feat.(map[string]interface{})
 		if !ok {
@@ -224,16 +484,9 @@ func (c *CityAQ) geojsonName(path, _ string) (string, error) {
 		if !ok {
 			continue
 		}
-		name, ok := propmap["c40_city_name"]
-		if !ok {
-			name, ok = propmap["name"]
-			if !ok {
-				return "", fmt.Errorf("file %s, missing name", path)
-			}
-		}
-		return name.(string), nil
+		return propmap, nil
 	}
-	return "", fmt.Errorf("couldn't find name in %v", data)
+	return nil, failedPrecondition("CITY_FILE", path, "cityaq: file %s has no feature properties", path)
 }
 
 // emissionsGrid returns the grid to be used for mapping gridded information about the requested city.
@@ -285,7 +538,11 @@ func (c *CityAQ) emissionsGrid(cityName, sourceType string, dx float64) ([]geom.
 // EmissionsGridBounds returns the bounds of the grid to be used for
 // mapping gridded information about the requested city.
 func (c *CityAQ) EmissionsGridBounds(ctx context.Context, req *rpc.EmissionsGridBoundsRequest) (*rpc.EmissionsGridBoundsResponse, error) {
-	o, err := c.emissionsGrid(req.CityName, req.SourceType, mapResolution(req.SourceType, req.CityName))
+	cf, err := c.cityFile(req.CityName)
+	if err != nil {
+		return nil, err
+	}
+	o, err := c.emissionsGrid(cf.name, req.SourceType, mapResolution(req.SourceType, cf.name))
 	if err != nil {
 		return nil, err
 	}
@@ -313,18 +570,20 @@ func (c *CityAQ) MapScale(ctx context.Context, req *rpc.MapScaleRequest) (*rpc.M
 			return nil, err
 		}
 		data = response.Emissions
-	case rpc.ImpactType_Concentrations:
+	case rpc.ImpactType_Concentrations, rpc.ImpactType_TotalConcentrations:
 		response, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
 			CityName:   req.CityName,
 			Emission:   req.Emission,
 			SourceType: req.SourceType,
+			TotalPM25:  req.ImpactType == rpc.ImpactType_TotalConcentrations,
+			Scenario:   req.Scenario,
 		})
 		if err != nil {
 			return nil, err
 		}
 		data = response.Concentrations
 	default:
-		return nil, fmt.Errorf("invalid impact type %s", req.ImpactType.String())
+		return nil, invalidArgument("ImpactType", "cityaq: invalid impact type %s", req.ImpactType.String())
 	}
 
 	min, max := math.Inf(1), math.Inf(-1)
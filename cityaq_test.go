@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -18,6 +19,8 @@ import (
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/palette/moreland"
 	"gonum.org/v1/plot/plotter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCityAQ_Cities(t *testing.T) {
@@ -29,14 +32,80 @@ func TestCityAQ_Cities(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	want := &rpc.CitiesResponse{
-		Names: []string{
-			"Accra Metropolitan",
-			"ڪراچي Karachi",
-		},
+	want := []string{
+		"Accra Metropolitan",
+		"ڪراچي Karachi",
+	}
+	if !reflect.DeepEqual(want, cities.Names) {
+		t.Errorf("%v != %v", cities.Names, want)
+	}
+	if len(cities.Cities) != len(want) {
+		t.Fatalf("have %d cities, want %d", len(cities.Cities), len(want))
+	}
+	for i, city := range cities.Cities {
+		if city.Name != want[i] {
+			t.Errorf("city %d: %s != %s", i, city.Name, want[i])
+		}
+	}
+}
+
+func TestCityAQ_Cities_language(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
 	}
-	if !reflect.DeepEqual(want, cities) {
-		t.Errorf("%v != %v", cities, want)
+	for _, test := range []struct {
+		language string
+		want     []string
+	}{
+		{language: "", want: []string{"Accra Metropolitan", "ڪراچي Karachi"}},
+		{language: "de", want: []string{"Accra Metropolitan", "Karatschi"}},
+		{language: "ur", want: []string{"Accra Metropolitan", "Karachi"}}, // English fallback
+	} {
+		t.Run(test.language, func(t *testing.T) {
+			cities, err := c.Cities(context.Background(), &rpc.CitiesRequest{Language: test.language})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.want, cities.Names) {
+				t.Errorf("%v != %v", cities.Names, test.want)
+			}
+			// Requests use the names in Cities or the IDs.
+			for _, city := range cities.Cities {
+				for _, name := range []string{city.Name, city.ID} {
+					if _, err := c.CityGeometry(context.Background(), &rpc.CityGeometryRequest{CityName: name}); err != nil {
+						t.Errorf("city %s: %v", name, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCityAQ_geojsonName_malformed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_geojson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := new(CityAQ)
+	for name, contents := range map[string]string{
+		"not_object":  `[1, 2]`,
+		"no_features": `{"type": "FeatureCollection"}`,
+		"no_props":    `{"type": "FeatureCollection", "features": [{"type": "Feature"}]}`,
+		"no_name":     `{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {}}]}`,
+		"number_name": `{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"name": 5}}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name+".geojson")
+			if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := c.geojsonName(path, "")
+			if status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("have error %v, want FailedPrecondition", err)
+			}
+		})
 	}
 }
 
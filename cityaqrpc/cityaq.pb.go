@@ -31,6 +31,86 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// JobState specifies the progress of a concentration model job.
+type JobState int32
+
+const (
+	// UNKNOWN_JOBSTATE means that the job has not been requested.
+	JobState_UNKNOWN_JOBSTATE JobState = 0
+	// Queued means that the job is waiting to be started.
+	JobState_Queued JobState = 1
+	// EmissionsGridding means that the emissions are being
+	// allocated to the model grid.
+	JobState_EmissionsGridding JobState = 2
+	// Submitted means that the job has been submitted to the model
+	// but has not yet started.
+	JobState_Submitted JobState = 3
+	// Running means that the model is running.
+	JobState_Running JobState = 4
+	// Downloading means that the model results are being retrieved.
+	JobState_Downloading JobState = 5
+	// Cached means that the job has finished and its results are
+	// available.
+	JobState_Cached JobState = 6
+	// Failed means that the job did not complete successfully.
+	JobState_Failed JobState = 7
+	// Canceled means that the job was stopped by CancelJob.
+	JobState_Canceled JobState = 8
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "UNKNOWN_JOBSTATE",
+		1: "Queued",
+		2: "EmissionsGridding",
+		3: "Submitted",
+		4: "Running",
+		5: "Downloading",
+		6: "Cached",
+		7: "Failed",
+		8: "Canceled",
+	}
+	JobState_value = map[string]int32{
+		"UNKNOWN_JOBSTATE":  0,
+		"Queued":            1,
+		"EmissionsGridding": 2,
+		"Submitted":         3,
+		"Running":           4,
+		"Downloading":       5,
+		"Cached":            6,
+		"Failed":            7,
+		"Canceled":          8,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityaq_proto_enumTypes[0].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_cityaq_proto_enumTypes[0]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{0}
+}
+
 type Emission int32
 
 const (
@@ -73,11 +153,11 @@ func (x Emission) String() string {
 }
 
 func (Emission) Descriptor() protoreflect.EnumDescriptor {
-	return file_cityaq_proto_enumTypes[0].Descriptor()
+	return file_cityaq_proto_enumTypes[1].Descriptor()
 }
 
 func (Emission) Type() protoreflect.EnumType {
-	return &file_cityaq_proto_enumTypes[0]
+	return &file_cityaq_proto_enumTypes[1]
 }
 
 func (x Emission) Number() protoreflect.EnumNumber {
@@ -86,15 +166,66 @@ func (x Emission) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Emission.Descriptor instead.
 func (Emission) EnumDescriptor() ([]byte, []int) {
-	return file_cityaq_proto_rawDescGZIP(), []int{0}
+	return file_cityaq_proto_rawDescGZIP(), []int{1}
+}
+
+// GridEncoding specifies how the cells of a grid are represented.
+type GridEncoding int32
+
+const (
+	// PolygonEncoding represents each cell as a polygon. It is the default.
+	GridEncoding_PolygonEncoding GridEncoding = 0
+	// CompactEncoding represents the cells using a CompactGrid message
+	// and all of the values of each cell as single-precision numbers.
+	GridEncoding_CompactEncoding GridEncoding = 1
+)
+
+// Enum value maps for GridEncoding.
+var (
+	GridEncoding_name = map[int32]string{
+		0: "PolygonEncoding",
+		1: "CompactEncoding",
+	}
+	GridEncoding_value = map[string]int32{
+		"PolygonEncoding": 0,
+		"CompactEncoding": 1,
+	}
+)
+
+func (x GridEncoding) Enum() *GridEncoding {
+	p := new(GridEncoding)
+	*p = x
+	return p
+}
+
+func (x GridEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GridEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityaq_proto_enumTypes[2].Descriptor()
+}
+
+func (GridEncoding) Type() protoreflect.EnumType {
+	return &file_cityaq_proto_enumTypes[2]
+}
+
+func (x GridEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GridEncoding.Descriptor instead.
+func (GridEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{2}
 }
 
 type ImpactType int32
 
 const (
-	ImpactType_UNKNOWN_IMPACTTYPE ImpactType = 0
-	ImpactType_Emissions          ImpactType = 1
-	ImpactType_Concentrations     ImpactType = 2
+	ImpactType_UNKNOWN_IMPACTTYPE  ImpactType = 0
+	ImpactType_Emissions           ImpactType = 1
+	ImpactType_Concentrations      ImpactType = 2
+	ImpactType_TotalConcentrations ImpactType = 3
 )
 
 // Enum value maps for ImpactType.
@@ -103,11 +234,13 @@ var (
 		0: "UNKNOWN_IMPACTTYPE",
 		1: "Emissions",
 		2: "Concentrations",
+		3: "TotalConcentrations",
 	}
 	ImpactType_value = map[string]int32{
-		"UNKNOWN_IMPACTTYPE": 0,
-		"Emissions":          1,
-		"Concentrations":     2,
+		"UNKNOWN_IMPACTTYPE":  0,
+		"Emissions":           1,
+		"Concentrations":      2,
+		"TotalConcentrations": 3,
 	}
 )
 
@@ -122,11 +255,11 @@ func (x ImpactType) String() string {
 }
 
 func (ImpactType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cityaq_proto_enumTypes[1].Descriptor()
+	return file_cityaq_proto_enumTypes[3].Descriptor()
 }
 
 func (ImpactType) Type() protoreflect.EnumType {
-	return &file_cityaq_proto_enumTypes[1]
+	return &file_cityaq_proto_enumTypes[3]
 }
 
 func (x ImpactType) Number() protoreflect.EnumNumber {
@@ -135,13 +268,77 @@ func (x ImpactType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImpactType.Descriptor instead.
 func (ImpactType) EnumDescriptor() ([]byte, []int) {
-	return file_cityaq_proto_rawDescGZIP(), []int{1}
+	return file_cityaq_proto_rawDescGZIP(), []int{3}
+}
+
+// ConcentrationResponse specifies a function relating changes in
+// PM2.5 concentrations to changes in mortality.
+type ConcentrationResponse int32
+
+const (
+	ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE ConcentrationResponse = 0
+	// Krewski2009 is a log-linear function with a 6% increase in
+	// all-cause mortality per 10 μg m-3 of PM2.5, from Krewski et
+	// al. (2009).
+	ConcentrationResponse_Krewski2009 ConcentrationResponse = 1
+	// GEMM is the Global Exposure Mortality Model for non-communicable
+	// diseases and lower respiratory infections, from Burnett et
+	// al. (2018).
+	ConcentrationResponse_GEMM ConcentrationResponse = 2
+)
+
+// Enum value maps for ConcentrationResponse.
+var (
+	ConcentrationResponse_name = map[int32]string{
+		0: "UNKNOWN_CONCENTRATIONRESPONSE",
+		1: "Krewski2009",
+		2: "GEMM",
+	}
+	ConcentrationResponse_value = map[string]int32{
+		"UNKNOWN_CONCENTRATIONRESPONSE": 0,
+		"Krewski2009":                   1,
+		"GEMM":                          2,
+	}
+)
+
+func (x ConcentrationResponse) Enum() *ConcentrationResponse {
+	p := new(ConcentrationResponse)
+	*p = x
+	return p
+}
+
+func (x ConcentrationResponse) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConcentrationResponse) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityaq_proto_enumTypes[4].Descriptor()
+}
+
+func (ConcentrationResponse) Type() protoreflect.EnumType {
+	return &file_cityaq_proto_enumTypes[4]
+}
+
+func (x ConcentrationResponse) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConcentrationResponse.Descriptor instead.
+func (ConcentrationResponse) EnumDescriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{4}
 }
 
 type CitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Language is the code of the language that the names of the cities
+	// should be in, such as "es" or "ur", as used in the name:xx
+	// properties of OpenStreetMap. Cities without a name in the language
+	// are given their English name. If it is empty, the names that are
+	// used in requests are returned.
+	Language string `protobuf:"bytes,1,opt,name=Language,proto3" json:"Language,omitempty"`
 }
 
 func (x *CitiesRequest) Reset() {
@@ -176,13 +373,23 @@ func (*CitiesRequest) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *CitiesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type CitiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The names of the cities
+	// The names of the cities, in the requested language. Localized
+	// names can't be used in requests: use City.Name or City.ID instead.
 	Names []string `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	// Information about each city, in the same order as Names.
+	Cities []*City `protobuf:"bytes,2,rep,name=Cities,proto3" json:"Cities,omitempty"`
 }
 
 func (x *CitiesResponse) Reset() {
@@ -224,16 +431,44 @@ func (x *CitiesResponse) GetNames() []string {
 	return nil
 }
 
-type CityGeometryRequest struct {
+func (x *CitiesResponse) GetCities() []*City {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+// City holds information about a city.
+type City struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-}
-
-func (x *CityGeometryRequest) Reset() {
-	*x = CityGeometryRequest{}
+	// ID is a stable identifier of the city, which is the path of the
+	// file containing its boundary, relative to the city directory and
+	// without the extension. It can be used in place of the name
+	// of the city in requests.
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Name is the name of the city that is used in requests.
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// DisplayNames holds the names of the city in different
+	// languages, from the name:xx GeoJSON properties, keyed by
+	// language code.
+	DisplayNames map[string]string `protobuf:"bytes,3,rep,name=DisplayNames,proto3" json:"DisplayNames,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Country is the name of the country that the city is in, or empty
+	// if it isn't known.
+	Country  string       `protobuf:"bytes,4,opt,name=Country,proto3" json:"Country,omitempty"`
+	Centroid *Point       `protobuf:"bytes,5,opt,name=Centroid,proto3" json:"Centroid,omitempty"`
+	Bounds   *BoundingBox `protobuf:"bytes,6,opt,name=Bounds,proto3" json:"Bounds,omitempty"`
+	// Area is the area of the city in km².
+	Area float64 `protobuf:"fixed64,7,opt,name=Area,proto3" json:"Area,omitempty"`
+	// Properties holds the properties of the city in its GeoJSON file,
+	// with each value in JSON format.
+	Properties map[string]string `protobuf:"bytes,8,rep,name=Properties,proto3" json:"Properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *City) Reset() {
+	*x = City{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -241,13 +476,13 @@ func (x *CityGeometryRequest) Reset() {
 	}
 }
 
-func (x *CityGeometryRequest) String() string {
+func (x *City) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CityGeometryRequest) ProtoMessage() {}
+func (*City) ProtoMessage() {}
 
-func (x *CityGeometryRequest) ProtoReflect() protoreflect.Message {
+func (x *City) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -259,28 +494,78 @@ func (x *CityGeometryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CityGeometryRequest.ProtoReflect.Descriptor instead.
-func (*CityGeometryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CityGeometryRequest) GetCityName() string {
+func (x *City) GetID() string {
 	if x != nil {
-		return x.CityName
+		return x.ID
 	}
 	return ""
 }
 
-type CityGeometryResponse struct {
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *City) GetDisplayNames() map[string]string {
+	if x != nil {
+		return x.DisplayNames
+	}
+	return nil
+}
+
+func (x *City) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *City) GetCentroid() *Point {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *City) GetBounds() *BoundingBox {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *City) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *City) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type BoundingBox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Polygons []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	Min *Point `protobuf:"bytes,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max *Point `protobuf:"bytes,2,opt,name=Max,proto3" json:"Max,omitempty"`
 }
 
-func (x *CityGeometryResponse) Reset() {
-	*x = CityGeometryResponse{}
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,13 +573,13 @@ func (x *CityGeometryResponse) Reset() {
 	}
 }
 
-func (x *CityGeometryResponse) String() string {
+func (x *BoundingBox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CityGeometryResponse) ProtoMessage() {}
+func (*BoundingBox) ProtoMessage() {}
 
-func (x *CityGeometryResponse) ProtoReflect() protoreflect.Message {
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,28 +591,33 @@ func (x *CityGeometryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CityGeometryResponse.ProtoReflect.Descriptor instead.
-func (*CityGeometryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CityGeometryResponse) GetPolygons() []*Polygon {
+func (x *BoundingBox) GetMin() *Point {
 	if x != nil {
-		return x.Polygons
+		return x.Min
 	}
 	return nil
 }
 
-type Polygon struct {
+func (x *BoundingBox) GetMax() *Point {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type OptionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Paths []*Path `protobuf:"bytes,1,rep,name=Paths,proto3" json:"Paths,omitempty"`
 }
 
-func (x *Polygon) Reset() {
-	*x = Polygon{}
+func (x *OptionsRequest) Reset() {
+	*x = OptionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +625,13 @@ func (x *Polygon) Reset() {
 	}
 }
 
-func (x *Polygon) String() string {
+func (x *OptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Polygon) ProtoMessage() {}
+func (*OptionsRequest) ProtoMessage() {}
 
-func (x *Polygon) ProtoReflect() protoreflect.Message {
+func (x *OptionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,28 +643,23 @@ func (x *Polygon) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
-func (*Polygon) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptionsRequest.ProtoReflect.Descriptor instead.
+func (*OptionsRequest) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Polygon) GetPaths() []*Path {
-	if x != nil {
-		return x.Paths
-	}
-	return nil
-}
-
-type Path struct {
+type OptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Points []*Point `protobuf:"bytes,1,rep,name=Points,proto3" json:"Points,omitempty"`
+	SourceTypes []*SourceTypeInfo `protobuf:"bytes,1,rep,name=SourceTypes,proto3" json:"SourceTypes,omitempty"`
+	Emissions   []*EmissionInfo   `protobuf:"bytes,2,rep,name=Emissions,proto3" json:"Emissions,omitempty"`
+	ImpactTypes []*ImpactTypeInfo `protobuf:"bytes,3,rep,name=ImpactTypes,proto3" json:"ImpactTypes,omitempty"`
 }
 
-func (x *Path) Reset() {
-	*x = Path{}
+func (x *OptionsResponse) Reset() {
+	*x = OptionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,13 +667,13 @@ func (x *Path) Reset() {
 	}
 }
 
-func (x *Path) String() string {
+func (x *OptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Path) ProtoMessage() {}
+func (*OptionsResponse) ProtoMessage() {}
 
-func (x *Path) ProtoReflect() protoreflect.Message {
+func (x *OptionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,29 +685,54 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Path.ProtoReflect.Descriptor instead.
-func (*Path) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptionsResponse.ProtoReflect.Descriptor instead.
+func (*OptionsResponse) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Path) GetPoints() []*Point {
+func (x *OptionsResponse) GetSourceTypes() []*SourceTypeInfo {
 	if x != nil {
-		return x.Points
+		return x.SourceTypes
 	}
 	return nil
 }
 
-type Point struct {
+func (x *OptionsResponse) GetEmissions() []*EmissionInfo {
+	if x != nil {
+		return x.Emissions
+	}
+	return nil
+}
+
+func (x *OptionsResponse) GetImpactTypes() []*ImpactTypeInfo {
+	if x != nil {
+		return x.ImpactTypes
+	}
+	return nil
+}
+
+// SourceTypeInfo describes a source type, which specifies the spatial
+// surrogate used to allocate emissions.
+type SourceTypeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X float64 `protobuf:"fixed64,1,opt,name=X,proto3" json:"X,omitempty"`
-	Y float64 `protobuf:"fixed64,2,opt,name=Y,proto3" json:"Y,omitempty"`
-}
-
-func (x *Point) Reset() {
-	*x = Point{}
+	// Name is the value to use as the SourceType field of requests.
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// EGUGrid specifies whether emissions are allocated to the country
+	// that the city is in (or the part of it near the city), rather
+	// than to the city itself.
+	EGUGrid bool `protobuf:"varint,4,opt,name=EGUGrid,proto3" json:"EGUGrid,omitempty"`
+	// OSMTags are the OpenStreetMap tags of the features used to
+	// allocate emissions, if the surrogate is based on OpenStreetMap.
+	OSMTags []*OSMTag `protobuf:"bytes,5,rep,name=OSMTags,proto3" json:"OSMTags,omitempty"`
+}
+
+func (x *SourceTypeInfo) Reset() {
+	*x = SourceTypeInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,13 +740,13 @@ func (x *Point) Reset() {
 	}
 }
 
-func (x *Point) String() string {
+func (x *SourceTypeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Point) ProtoMessage() {}
+func (*SourceTypeInfo) ProtoMessage() {}
 
-func (x *Point) ProtoReflect() protoreflect.Message {
+func (x *SourceTypeInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -448,37 +758,59 @@ func (x *Point) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Point.ProtoReflect.Descriptor instead.
-func (*Point) Descriptor() ([]byte, []int) {
+// Deprecated: Use SourceTypeInfo.ProtoReflect.Descriptor instead.
+func (*SourceTypeInfo) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Point) GetX() float64 {
+func (x *SourceTypeInfo) GetName() string {
 	if x != nil {
-		return x.X
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Point) GetY() float64 {
+func (x *SourceTypeInfo) GetDisplayName() string {
 	if x != nil {
-		return x.Y
+		return x.DisplayName
 	}
-	return 0
+	return ""
 }
 
-type GriddedEmissionsRequest struct {
+func (x *SourceTypeInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SourceTypeInfo) GetEGUGrid() bool {
+	if x != nil {
+		return x.EGUGrid
+	}
+	return false
+}
+
+func (x *SourceTypeInfo) GetOSMTags() []*OSMTag {
+	if x != nil {
+		return x.OSMTags
+	}
+	return nil
+}
+
+// OSMTag is an OpenStreetMap tag key and the values it can have.
+// If there are no values, any value matches.
+type OSMTag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	Key    string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=Values,proto3" json:"Values,omitempty"`
 }
 
-func (x *GriddedEmissionsRequest) Reset() {
-	*x = GriddedEmissionsRequest{}
+func (x *OSMTag) Reset() {
+	*x = OSMTag{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,13 +818,13 @@ func (x *GriddedEmissionsRequest) Reset() {
 	}
 }
 
-func (x *GriddedEmissionsRequest) String() string {
+func (x *OSMTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedEmissionsRequest) ProtoMessage() {}
+func (*OSMTag) ProtoMessage() {}
 
-func (x *GriddedEmissionsRequest) ProtoReflect() protoreflect.Message {
+func (x *OSMTag) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,43 +836,40 @@ func (x *GriddedEmissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedEmissionsRequest.ProtoReflect.Descriptor instead.
-func (*GriddedEmissionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OSMTag.ProtoReflect.Descriptor instead.
+func (*OSMTag) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GriddedEmissionsRequest) GetCityName() string {
+func (x *OSMTag) GetKey() string {
 	if x != nil {
-		return x.CityName
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GriddedEmissionsRequest) GetSourceType() string {
+func (x *OSMTag) GetValues() []string {
 	if x != nil {
-		return x.SourceType
-	}
-	return ""
-}
-
-func (x *GriddedEmissionsRequest) GetEmission() Emission {
-	if x != nil {
-		return x.Emission
+		return x.Values
 	}
-	return Emission_UNKNOWN_EMISSION
+	return nil
 }
 
-type GriddedEmissionsResponse struct {
+// EmissionInfo describes a pollutant that can be emitted.
+type EmissionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Polygons  []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Emissions []float64  `protobuf:"fixed64,2,rep,packed,name=Emissions,proto3" json:"Emissions,omitempty"`
+	Emission    Emission `protobuf:"varint,1,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	DisplayName string   `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// Units are the units of gridded emissions of the pollutant.
+	Units string `protobuf:"bytes,4,opt,name=Units,proto3" json:"Units,omitempty"`
 }
 
-func (x *GriddedEmissionsResponse) Reset() {
-	*x = GriddedEmissionsResponse{}
+func (x *EmissionInfo) Reset() {
+	*x = EmissionInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +877,13 @@ func (x *GriddedEmissionsResponse) Reset() {
 	}
 }
 
-func (x *GriddedEmissionsResponse) String() string {
+func (x *EmissionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedEmissionsResponse) ProtoMessage() {}
+func (*EmissionInfo) ProtoMessage() {}
 
-func (x *GriddedEmissionsResponse) ProtoReflect() protoreflect.Message {
+func (x *EmissionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,37 +895,54 @@ func (x *GriddedEmissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedEmissionsResponse.ProtoReflect.Descriptor instead.
-func (*GriddedEmissionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmissionInfo.ProtoReflect.Descriptor instead.
+func (*EmissionInfo) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GriddedEmissionsResponse) GetPolygons() []*Polygon {
+func (x *EmissionInfo) GetEmission() Emission {
 	if x != nil {
-		return x.Polygons
+		return x.Emission
 	}
-	return nil
+	return Emission_UNKNOWN_EMISSION
 }
 
-func (x *GriddedEmissionsResponse) GetEmissions() []float64 {
+func (x *EmissionInfo) GetDisplayName() string {
 	if x != nil {
-		return x.Emissions
+		return x.DisplayName
 	}
-	return nil
+	return ""
 }
 
-type GriddedConcentrationsRequest struct {
+func (x *EmissionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+// ImpactTypeInfo describes a type of impact.
+type ImpactTypeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	ImpactType  ImpactType `protobuf:"varint,1,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
+	DisplayName string     `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string     `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// Units are the units of gridded values of the impact.
+	Units string `protobuf:"bytes,4,opt,name=Units,proto3" json:"Units,omitempty"`
 }
 
-func (x *GriddedConcentrationsRequest) Reset() {
-	*x = GriddedConcentrationsRequest{}
+func (x *ImpactTypeInfo) Reset() {
+	*x = ImpactTypeInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +950,13 @@ func (x *GriddedConcentrationsRequest) Reset() {
 	}
 }
 
-func (x *GriddedConcentrationsRequest) String() string {
+func (x *ImpactTypeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedConcentrationsRequest) ProtoMessage() {}
+func (*ImpactTypeInfo) ProtoMessage() {}
 
-func (x *GriddedConcentrationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ImpactTypeInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,43 +968,49 @@ func (x *GriddedConcentrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedConcentrationsRequest.ProtoReflect.Descriptor instead.
-func (*GriddedConcentrationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImpactTypeInfo.ProtoReflect.Descriptor instead.
+func (*ImpactTypeInfo) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GriddedConcentrationsRequest) GetCityName() string {
+func (x *ImpactTypeInfo) GetImpactType() ImpactType {
 	if x != nil {
-		return x.CityName
+		return x.ImpactType
+	}
+	return ImpactType_UNKNOWN_IMPACTTYPE
+}
+
+func (x *ImpactTypeInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *GriddedConcentrationsRequest) GetSourceType() string {
+func (x *ImpactTypeInfo) GetDescription() string {
 	if x != nil {
-		return x.SourceType
+		return x.Description
 	}
 	return ""
 }
 
-func (x *GriddedConcentrationsRequest) GetEmission() Emission {
+func (x *ImpactTypeInfo) GetUnits() string {
 	if x != nil {
-		return x.Emission
+		return x.Units
 	}
-	return Emission_UNKNOWN_EMISSION
+	return ""
 }
 
-type GriddedConcentrationsResponse struct {
+type CityGeometryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Polygons       []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Concentrations []float64  `protobuf:"fixed64,2,rep,packed,name=Concentrations,proto3" json:"Concentrations,omitempty"`
+	CityName string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
 }
 
-func (x *GriddedConcentrationsResponse) Reset() {
-	*x = GriddedConcentrationsResponse{}
+func (x *CityGeometryRequest) Reset() {
+	*x = CityGeometryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,13 +1018,13 @@ func (x *GriddedConcentrationsResponse) Reset() {
 	}
 }
 
-func (x *GriddedConcentrationsResponse) String() string {
+func (x *CityGeometryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedConcentrationsResponse) ProtoMessage() {}
+func (*CityGeometryRequest) ProtoMessage() {}
 
-func (x *GriddedConcentrationsResponse) ProtoReflect() protoreflect.Message {
+func (x *CityGeometryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,37 +1036,28 @@ func (x *GriddedConcentrationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedConcentrationsResponse.ProtoReflect.Descriptor instead.
-func (*GriddedConcentrationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CityGeometryRequest.ProtoReflect.Descriptor instead.
+func (*CityGeometryRequest) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GriddedConcentrationsResponse) GetPolygons() []*Polygon {
-	if x != nil {
-		return x.Polygons
-	}
-	return nil
-}
-
-func (x *GriddedConcentrationsResponse) GetConcentrations() []float64 {
+func (x *CityGeometryRequest) GetCityName() string {
 	if x != nil {
-		return x.Concentrations
+		return x.CityName
 	}
-	return nil
+	return ""
 }
 
-type GriddedPopulationRequest struct {
+type CityGeometryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	Polygons []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
 }
 
-func (x *GriddedPopulationRequest) Reset() {
-	*x = GriddedPopulationRequest{}
+func (x *CityGeometryResponse) Reset() {
+	*x = CityGeometryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +1065,13 @@ func (x *GriddedPopulationRequest) Reset() {
 	}
 }
 
-func (x *GriddedPopulationRequest) String() string {
+func (x *CityGeometryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedPopulationRequest) ProtoMessage() {}
+func (*CityGeometryResponse) ProtoMessage() {}
 
-func (x *GriddedPopulationRequest) ProtoReflect() protoreflect.Message {
+func (x *CityGeometryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,43 +1083,28 @@ func (x *GriddedPopulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedPopulationRequest.ProtoReflect.Descriptor instead.
-func (*GriddedPopulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CityGeometryResponse.ProtoReflect.Descriptor instead.
+func (*CityGeometryResponse) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GriddedPopulationRequest) GetCityName() string {
-	if x != nil {
-		return x.CityName
-	}
-	return ""
-}
-
-func (x *GriddedPopulationRequest) GetSourceType() string {
-	if x != nil {
-		return x.SourceType
-	}
-	return ""
-}
-
-func (x *GriddedPopulationRequest) GetEmission() Emission {
+func (x *CityGeometryResponse) GetPolygons() []*Polygon {
 	if x != nil {
-		return x.Emission
+		return x.Polygons
 	}
-	return Emission_UNKNOWN_EMISSION
+	return nil
 }
 
-type GriddedPopulationResponse struct {
+type Polygon struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Polygons   []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Population []float64  `protobuf:"fixed64,2,rep,packed,name=Population,proto3" json:"Population,omitempty"`
+	Paths []*Path `protobuf:"bytes,1,rep,name=Paths,proto3" json:"Paths,omitempty"`
 }
 
-func (x *GriddedPopulationResponse) Reset() {
-	*x = GriddedPopulationResponse{}
+func (x *Polygon) Reset() {
+	*x = Polygon{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,13 +1112,13 @@ func (x *GriddedPopulationResponse) Reset() {
 	}
 }
 
-func (x *GriddedPopulationResponse) String() string {
+func (x *Polygon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GriddedPopulationResponse) ProtoMessage() {}
+func (*Polygon) ProtoMessage() {}
 
-func (x *GriddedPopulationResponse) ProtoReflect() protoreflect.Message {
+func (x *Polygon) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -802,37 +1130,28 @@ func (x *GriddedPopulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GriddedPopulationResponse.ProtoReflect.Descriptor instead.
-func (*GriddedPopulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GriddedPopulationResponse) GetPolygons() []*Polygon {
-	if x != nil {
-		return x.Polygons
-	}
-	return nil
-}
-
-func (x *GriddedPopulationResponse) GetPopulation() []float64 {
+func (x *Polygon) GetPaths() []*Path {
 	if x != nil {
-		return x.Population
+		return x.Paths
 	}
 	return nil
 }
 
-type ImpactSummaryRequest struct {
+type Path struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	Points []*Point `protobuf:"bytes,1,rep,name=Points,proto3" json:"Points,omitempty"`
 }
 
-func (x *ImpactSummaryRequest) Reset() {
-	*x = ImpactSummaryRequest{}
+func (x *Path) Reset() {
+	*x = Path{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -840,13 +1159,13 @@ func (x *ImpactSummaryRequest) Reset() {
 	}
 }
 
-func (x *ImpactSummaryRequest) String() string {
+func (x *Path) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImpactSummaryRequest) ProtoMessage() {}
+func (*Path) ProtoMessage() {}
 
-func (x *ImpactSummaryRequest) ProtoReflect() protoreflect.Message {
+func (x *Path) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,55 +1177,29 @@ func (x *ImpactSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImpactSummaryRequest.ProtoReflect.Descriptor instead.
-func (*ImpactSummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Path.ProtoReflect.Descriptor instead.
+func (*Path) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ImpactSummaryRequest) GetCityName() string {
-	if x != nil {
-		return x.CityName
-	}
-	return ""
-}
-
-func (x *ImpactSummaryRequest) GetSourceType() string {
-	if x != nil {
-		return x.SourceType
-	}
-	return ""
-}
-
-func (x *ImpactSummaryRequest) GetEmission() Emission {
+func (x *Path) GetPoints() []*Point {
 	if x != nil {
-		return x.Emission
+		return x.Points
 	}
-	return Emission_UNKNOWN_EMISSION
+	return nil
 }
 
-type ImpactSummaryResponse struct {
+type Point struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Population is the total population.
-	Population float64 `protobuf:"fixed64,1,opt,name=Population,proto3" json:"Population,omitempty"`
-	// CityPopulation is the total population in the city.
-	CityPopulation float64 `protobuf:"fixed64,2,opt,name=CityPopulation,proto3" json:"CityPopulation,omitempty"`
-	// CityExposure is the population-weighted average
-	// concentration in the city.
-	CityExposure float64 `protobuf:"fixed64,3,opt,name=CityExposure,proto3" json:"CityExposure,omitempty"`
-	// TotalExposure is the overall population-weighted average
-	// concentration.
-	TotalExposure float64 `protobuf:"fixed64,4,opt,name=TotalExposure,proto3" json:"TotalExposure,omitempty"`
-	// CityIF is the intake fraction in the city.
-	CityIF float64 `protobuf:"fixed64,5,opt,name=CityIF,proto3" json:"CityIF,omitempty"`
-	// TotalIF is the total intake fraction.
-	TotalIF float64 `protobuf:"fixed64,6,opt,name=TotalIF,proto3" json:"TotalIF,omitempty"`
+	X float64 `protobuf:"fixed64,1,opt,name=X,proto3" json:"X,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=Y,proto3" json:"Y,omitempty"`
 }
 
-func (x *ImpactSummaryResponse) Reset() {
-	*x = ImpactSummaryResponse{}
+func (x *Point) Reset() {
+	*x = Point{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,13 +1207,13 @@ func (x *ImpactSummaryResponse) Reset() {
 	}
 }
 
-func (x *ImpactSummaryResponse) String() string {
+func (x *Point) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImpactSummaryResponse) ProtoMessage() {}
+func (*Point) ProtoMessage() {}
 
-func (x *ImpactSummaryResponse) ProtoReflect() protoreflect.Message {
+func (x *Point) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,64 +1225,48 @@ func (x *ImpactSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImpactSummaryResponse.ProtoReflect.Descriptor instead.
-func (*ImpactSummaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ImpactSummaryResponse) GetPopulation() float64 {
+func (x *Point) GetX() float64 {
 	if x != nil {
-		return x.Population
+		return x.X
 	}
 	return 0
 }
 
-func (x *ImpactSummaryResponse) GetCityPopulation() float64 {
+func (x *Point) GetY() float64 {
 	if x != nil {
-		return x.CityPopulation
+		return x.Y
 	}
 	return 0
 }
 
-func (x *ImpactSummaryResponse) GetCityExposure() float64 {
-	if x != nil {
-		return x.CityExposure
-	}
-	return 0
-}
+type GriddedEmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ImpactSummaryResponse) GetTotalExposure() float64 {
-	if x != nil {
-		return x.TotalExposure
-	}
-	return 0
-}
-
-func (x *ImpactSummaryResponse) GetCityIF() float64 {
-	if x != nil {
-		return x.CityIF
-	}
-	return 0
-}
-
-func (x *ImpactSummaryResponse) GetTotalIF() float64 {
-	if x != nil {
-		return x.TotalIF
-	}
-	return 0
-}
-
-type EmissionsGridBoundsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding GridEncoding `protobuf:"varint,6,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
 }
 
-func (x *EmissionsGridBoundsRequest) Reset() {
-	*x = EmissionsGridBoundsRequest{}
+func (x *GriddedEmissionsRequest) Reset() {
+	*x = GriddedEmissionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -997,13 +1274,13 @@ func (x *EmissionsGridBoundsRequest) Reset() {
 	}
 }
 
-func (x *EmissionsGridBoundsRequest) String() string {
+func (x *GriddedEmissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmissionsGridBoundsRequest) ProtoMessage() {}
+func (*GriddedEmissionsRequest) ProtoMessage() {}
 
-func (x *EmissionsGridBoundsRequest) ProtoReflect() protoreflect.Message {
+func (x *GriddedEmissionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1015,36 +1292,68 @@ func (x *EmissionsGridBoundsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmissionsGridBoundsRequest.ProtoReflect.Descriptor instead.
-func (*EmissionsGridBoundsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GriddedEmissionsRequest.ProtoReflect.Descriptor instead.
+func (*GriddedEmissionsRequest) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *EmissionsGridBoundsRequest) GetCityName() string {
+func (x *GriddedEmissionsRequest) GetCityName() string {
 	if x != nil {
 		return x.CityName
 	}
 	return ""
 }
 
-func (x *EmissionsGridBoundsRequest) GetSourceType() string {
+func (x *GriddedEmissionsRequest) GetSourceType() string {
 	if x != nil {
 		return x.SourceType
 	}
 	return ""
 }
 
-type EmissionsGridBoundsResponse struct {
+func (x *GriddedEmissionsRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *GriddedEmissionsRequest) GetEmissionAmount() float64 {
+	if x != nil {
+		return x.EmissionAmount
+	}
+	return 0
+}
+
+func (x *GriddedEmissionsRequest) GetEmissionUnit() string {
+	if x != nil {
+		return x.EmissionUnit
+	}
+	return ""
+}
+
+func (x *GriddedEmissionsRequest) GetEncoding() GridEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedEmissionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min *Point `protobuf:"bytes,1,opt,name=Min,proto3" json:"Min,omitempty"`
-	Max *Point `protobuf:"bytes,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	Polygons  []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	Emissions []float64  `protobuf:"fixed64,2,rep,packed,name=Emissions,proto3" json:"Emissions,omitempty"`
+	// Grid and Values replace Polygons and Emissions when the
+	// request specifies CompactEncoding.
+	Grid   *CompactGrid `protobuf:"bytes,3,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values []float32    `protobuf:"fixed32,4,rep,packed,name=Values,proto3" json:"Values,omitempty"`
 }
 
-func (x *EmissionsGridBoundsResponse) Reset() {
-	*x = EmissionsGridBoundsResponse{}
+func (x *GriddedEmissionsResponse) Reset() {
+	*x = GriddedEmissionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,13 +1361,13 @@ func (x *EmissionsGridBoundsResponse) Reset() {
 	}
 }
 
-func (x *EmissionsGridBoundsResponse) String() string {
+func (x *GriddedEmissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmissionsGridBoundsResponse) ProtoMessage() {}
+func (*GriddedEmissionsResponse) ProtoMessage() {}
 
-func (x *EmissionsGridBoundsResponse) ProtoReflect() protoreflect.Message {
+func (x *GriddedEmissionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,38 +1379,72 @@ func (x *EmissionsGridBoundsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmissionsGridBoundsResponse.ProtoReflect.Descriptor instead.
-func (*EmissionsGridBoundsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GriddedEmissionsResponse.ProtoReflect.Descriptor instead.
+func (*GriddedEmissionsResponse) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EmissionsGridBoundsResponse) GetMin() *Point {
+func (x *GriddedEmissionsResponse) GetPolygons() []*Polygon {
 	if x != nil {
-		return x.Min
+		return x.Polygons
 	}
 	return nil
 }
 
-func (x *EmissionsGridBoundsResponse) GetMax() *Point {
+func (x *GriddedEmissionsResponse) GetEmissions() []float64 {
 	if x != nil {
-		return x.Max
+		return x.Emissions
 	}
 	return nil
 }
 
-type MapScaleRequest struct {
+func (x *GriddedEmissionsResponse) GetGrid() *CompactGrid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *GriddedEmissionsResponse) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GriddedConcentrationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityName   string     `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	ImpactType ImpactType `protobuf:"varint,2,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
-	Emission   Emission   `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
-	SourceType string     `protobuf:"bytes,4,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// Scenario optionally specifies a source that emits several pollutants
+	// at once. If it is set, concentrations are calculated for the combined
+	// emissions of all of the pollutants in the scenario, and Emission,
+	// EmissionAmount and EmissionUnit are ignored.
+	Scenario *EmissionScenario `protobuf:"bytes,6,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	// TotalPM25 specifies that Concentrations should hold total PM2.5,
+	// the sum of all PM2.5 species, resulting from emissions of every
+	// precursor at the requested rate rather than only the species that
+	// corresponds to Emission.
+	TotalPM25 bool `protobuf:"varint,7,opt,name=TotalPM25,proto3" json:"TotalPM25,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding GridEncoding `protobuf:"varint,8,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
 }
 
-func (x *MapScaleRequest) Reset() {
-	*x = MapScaleRequest{}
+func (x *GriddedConcentrationsRequest) Reset() {
+	*x = GriddedConcentrationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,13 +1452,13 @@ func (x *MapScaleRequest) Reset() {
 	}
 }
 
-func (x *MapScaleRequest) String() string {
+func (x *GriddedConcentrationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapScaleRequest) ProtoMessage() {}
+func (*GriddedConcentrationsRequest) ProtoMessage() {}
 
-func (x *MapScaleRequest) ProtoReflect() protoreflect.Message {
+func (x *GriddedConcentrationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,51 +1470,102 @@ func (x *MapScaleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapScaleRequest.ProtoReflect.Descriptor instead.
-func (*MapScaleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GriddedConcentrationsRequest.ProtoReflect.Descriptor instead.
+func (*GriddedConcentrationsRequest) Descriptor() ([]byte, []int) {
 	return file_cityaq_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MapScaleRequest) GetCityName() string {
+func (x *GriddedConcentrationsRequest) GetCityName() string {
 	if x != nil {
 		return x.CityName
 	}
 	return ""
 }
 
-func (x *MapScaleRequest) GetImpactType() ImpactType {
+func (x *GriddedConcentrationsRequest) GetSourceType() string {
 	if x != nil {
-		return x.ImpactType
+		return x.SourceType
 	}
-	return ImpactType_UNKNOWN_IMPACTTYPE
+	return ""
 }
 
-func (x *MapScaleRequest) GetEmission() Emission {
+func (x *GriddedConcentrationsRequest) GetEmission() Emission {
 	if x != nil {
 		return x.Emission
 	}
 	return Emission_UNKNOWN_EMISSION
 }
 
-func (x *MapScaleRequest) GetSourceType() string {
+func (x *GriddedConcentrationsRequest) GetEmissionAmount() float64 {
 	if x != nil {
-		return x.SourceType
+		return x.EmissionAmount
+	}
+	return 0
+}
+
+func (x *GriddedConcentrationsRequest) GetEmissionUnit() string {
+	if x != nil {
+		return x.EmissionUnit
 	}
 	return ""
 }
 
-type MapScaleResponse struct {
+func (x *GriddedConcentrationsRequest) GetScenario() *EmissionScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsRequest) GetTotalPM25() bool {
+	if x != nil {
+		return x.TotalPM25
+	}
+	return false
+}
+
+func (x *GriddedConcentrationsRequest) GetEncoding() GridEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedConcentrationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min   float64 `protobuf:"fixed64,1,opt,name=Min,proto3" json:"Min,omitempty"`
-	Max   float64 `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty"`
-	CutPt float64 `protobuf:"fixed64,3,opt,name=CutPt,proto3" json:"CutPt,omitempty"`
+	Polygons []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	// Concentrations holds the concentrations of the PM2.5 species that
+	// corresponds to the requested emission, or of total PM2.5 if the
+	// request specifies a Scenario or TotalPM25.
+	Concentrations []float64 `protobuf:"fixed64,2,rep,packed,name=Concentrations,proto3" json:"Concentrations,omitempty"`
+	// The fields below hold the concentrations of each PM2.5 species.
+	// They are only set if the request specifies a Scenario.
+	PrimaryPM25 []float64 `protobuf:"fixed64,3,rep,packed,name=PrimaryPM25,proto3" json:"PrimaryPM25,omitempty"`
+	PNH4        []float64 `protobuf:"fixed64,4,rep,packed,name=PNH4,proto3" json:"PNH4,omitempty"`
+	PNO3        []float64 `protobuf:"fixed64,5,rep,packed,name=PNO3,proto3" json:"PNO3,omitempty"`
+	PSO4        []float64 `protobuf:"fixed64,6,rep,packed,name=PSO4,proto3" json:"PSO4,omitempty"`
+	SOA         []float64 `protobuf:"fixed64,7,rep,packed,name=SOA,proto3" json:"SOA,omitempty"`
+	// BaselineTotalPM25 holds the total PM2.5 concentrations from all
+	// sources in the model input data.
+	BaselineTotalPM25 []float64 `protobuf:"fixed64,9,rep,packed,name=BaselineTotalPM25,proto3" json:"BaselineTotalPM25,omitempty"`
+	// When the request specifies CompactEncoding, Grid replaces Polygons,
+	// and the single-precision fields below replace Concentrations and
+	// the double-precision fields with the same name without "Values".
+	Grid                    *CompactGrid `protobuf:"bytes,10,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values                  []float32    `protobuf:"fixed32,11,rep,packed,name=Values,proto3" json:"Values,omitempty"`
+	PrimaryPM25Values       []float32    `protobuf:"fixed32,12,rep,packed,name=PrimaryPM25Values,proto3" json:"PrimaryPM25Values,omitempty"`
+	PNH4Values              []float32    `protobuf:"fixed32,13,rep,packed,name=PNH4Values,proto3" json:"PNH4Values,omitempty"`
+	PNO3Values              []float32    `protobuf:"fixed32,14,rep,packed,name=PNO3Values,proto3" json:"PNO3Values,omitempty"`
+	PSO4Values              []float32    `protobuf:"fixed32,15,rep,packed,name=PSO4Values,proto3" json:"PSO4Values,omitempty"`
+	SOAValues               []float32    `protobuf:"fixed32,16,rep,packed,name=SOAValues,proto3" json:"SOAValues,omitempty"`
+	BaselineTotalPM25Values []float32    `protobuf:"fixed32,17,rep,packed,name=BaselineTotalPM25Values,proto3" json:"BaselineTotalPM25Values,omitempty"`
 }
 
-func (x *MapScaleResponse) Reset() {
-	*x = MapScaleResponse{}
+func (x *GriddedConcentrationsResponse) Reset() {
+	*x = GriddedConcentrationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cityaq_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,314 +1573,3156 @@ func (x *MapScaleResponse) Reset() {
 	}
 }
 
-func (x *MapScaleResponse) String() string {
+func (x *GriddedConcentrationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapScaleResponse) ProtoMessage() {}
+func (*GriddedConcentrationsResponse) ProtoMessage() {}
 
-func (x *MapScaleResponse) ProtoReflect() protoreflect.Message {
+func (x *GriddedConcentrationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cityaq_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MapScaleResponse.ProtoReflect.Descriptor instead.
-func (*MapScaleResponse) Descriptor() ([]byte, []int) {
-	return file_cityaq_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *MapScaleResponse) GetMin() float64 {
-	if x != nil {
-		return x.Min
-	}
-	return 0
-}
-
-func (x *MapScaleResponse) GetMax() float64 {
-	if x != nil {
-		return x.Max
-	}
-	return 0
-}
-
-func (x *MapScaleResponse) GetCutPt() float64 {
-	if x != nil {
-		return x.CutPt
-	}
-	return 0
-}
-
-var File_cityaq_proto protoreflect.FileDescriptor
-
-var file_cityaq_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
-	0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x0e, 0x43, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f,
-	0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79,
-	0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a,
-	0x07, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22,
-	0x30, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x22, 0x23, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x58, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x58, 0x12, 0x0c, 0x0a, 0x01, 0x59, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x01, 0x59, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x47, 0x72, 0x69, 0x64, 0x64,
-	0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f,
-	0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x68, 0x0a, 0x18, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50,
-	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f,
-	0x6e, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09,
-	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1c, 0x47, 0x72,
-	0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69,
-	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69,
-	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x1d, 0x47, 0x72, 0x69, 0x64, 0x64,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79,
-	0x67, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74,
-	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08,
-	0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x63,
-	0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01,
-	0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x87, 0x01, 0x0a, 0x18, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69,
-	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x19, 0x47, 0x72,
-	0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74, 0x79,
-	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50,
-	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x50, 0x6f, 0x70,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x61,
-	0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08,
-	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x01,
-	0x0a, 0x15, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x50, 0x6f, 0x70,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x50,
-	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0e, 0x43, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x43, 0x69, 0x74, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x43, 0x69, 0x74, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x73,
-	0x75, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x6f,
-	0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69, 0x74,
-	0x79, 0x49, 0x46, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x43, 0x69, 0x74, 0x79, 0x49,
-	0x46, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x46, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x07, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x46, 0x22, 0x58, 0x0a, 0x1a, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x65, 0x0a, 0x1b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x22, 0xb5, 0x01, 0x0a,
-	0x0f, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a,
-	0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x61,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x43, 0x75, 0x74, 0x50, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x43, 0x75, 0x74,
-	0x50, 0x74, 0x2a, 0x4f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4d, 0x32, 0x5f, 0x35, 0x10, 0x01, 0x12,
-	0x07, 0x0a, 0x03, 0x4e, 0x48, 0x33, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x78, 0x10,
-	0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4f, 0x78, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x4f,
-	0x43, 0x10, 0x05, 0x2a, 0x47, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x4d, 0x50,
-	0x41, 0x43, 0x54, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x63,
-	0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x02, 0x32, 0xd0, 0x05, 0x0a,
-	0x06, 0x43, 0x69, 0x74, 0x79, 0x41, 0x51, 0x12, 0x3f, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x69,
-	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x69, 0x74, 0x79,
-	0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x47,
-	0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x22, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64,
-	0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x13, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x12, 0x25, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72,
-	0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6c, 0x0a, 0x15, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e,
-	0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x69,
-	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43,
-	0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x63,
-	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
-	0x71, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x72, 0x69, 0x64, 0x64,
-	0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63,
-	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64,
-	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72,
-	0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x49, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x69, 0x74,
-	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x69,
-	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x0b, 0x5a, 0x09, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cityaq_proto_rawDescOnce sync.Once
-	file_cityaq_proto_rawDescData = file_cityaq_proto_rawDesc
-)
-
-func file_cityaq_proto_rawDescGZIP() []byte {
-	file_cityaq_proto_rawDescOnce.Do(func() {
-		file_cityaq_proto_rawDescData = protoimpl.X.CompressGZIP(file_cityaq_proto_rawDescData)
-	})
-	return file_cityaq_proto_rawDescData
-}
-
-var file_cityaq_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cityaq_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_cityaq_proto_goTypes = []interface{}{
-	(Emission)(0),                         // 0: cityaqrpc.Emission
-	(ImpactType)(0),                       // 1: cityaqrpc.ImpactType
-	(*CitiesRequest)(nil),                 // 2: cityaqrpc.CitiesRequest
-	(*CitiesResponse)(nil),                // 3: cityaqrpc.CitiesResponse
-	(*CityGeometryRequest)(nil),           // 4: cityaqrpc.CityGeometryRequest
-	(*CityGeometryResponse)(nil),          // 5: cityaqrpc.CityGeometryResponse
-	(*Polygon)(nil),                       // 6: cityaqrpc.Polygon
-	(*Path)(nil),                          // 7: cityaqrpc.Path
-	(*Point)(nil),                         // 8: cityaqrpc.Point
-	(*GriddedEmissionsRequest)(nil),       // 9: cityaqrpc.GriddedEmissionsRequest
-	(*GriddedEmissionsResponse)(nil),      // 10: cityaqrpc.GriddedEmissionsResponse
-	(*GriddedConcentrationsRequest)(nil),  // 11: cityaqrpc.GriddedConcentrationsRequest
-	(*GriddedConcentrationsResponse)(nil), // 12: cityaqrpc.GriddedConcentrationsResponse
-	(*GriddedPopulationRequest)(nil),      // 13: cityaqrpc.GriddedPopulationRequest
-	(*GriddedPopulationResponse)(nil),     // 14: cityaqrpc.GriddedPopulationResponse
-	(*ImpactSummaryRequest)(nil),          // 15: cityaqrpc.ImpactSummaryRequest
-	(*ImpactSummaryResponse)(nil),         // 16: cityaqrpc.ImpactSummaryResponse
-	(*EmissionsGridBoundsRequest)(nil),    // 17: cityaqrpc.EmissionsGridBoundsRequest
-	(*EmissionsGridBoundsResponse)(nil),   // 18: cityaqrpc.EmissionsGridBoundsResponse
-	(*MapScaleRequest)(nil),               // 19: cityaqrpc.MapScaleRequest
-	(*MapScaleResponse)(nil),              // 20: cityaqrpc.MapScaleResponse
-}
-var file_cityaq_proto_depIdxs = []int32{
-	6,  // 0: cityaqrpc.CityGeometryResponse.Polygons:type_name -> cityaqrpc.Polygon
-	7,  // 1: cityaqrpc.Polygon.Paths:type_name -> cityaqrpc.Path
-	8,  // 2: cityaqrpc.Path.Points:type_name -> cityaqrpc.Point
-	0,  // 3: cityaqrpc.GriddedEmissionsRequest.Emission:type_name -> cityaqrpc.Emission
-	6,  // 4: cityaqrpc.GriddedEmissionsResponse.Polygons:type_name -> cityaqrpc.Polygon
-	0,  // 5: cityaqrpc.GriddedConcentrationsRequest.Emission:type_name -> cityaqrpc.Emission
-	6,  // 6: cityaqrpc.GriddedConcentrationsResponse.Polygons:type_name -> cityaqrpc.Polygon
-	0,  // 7: cityaqrpc.GriddedPopulationRequest.Emission:type_name -> cityaqrpc.Emission
-	6,  // 8: cityaqrpc.GriddedPopulationResponse.Polygons:type_name -> cityaqrpc.Polygon
-	0,  // 9: cityaqrpc.ImpactSummaryRequest.Emission:type_name -> cityaqrpc.Emission
-	8,  // 10: cityaqrpc.EmissionsGridBoundsResponse.Min:type_name -> cityaqrpc.Point
-	8,  // 11: cityaqrpc.EmissionsGridBoundsResponse.Max:type_name -> cityaqrpc.Point
-	1,  // 12: cityaqrpc.MapScaleRequest.ImpactType:type_name -> cityaqrpc.ImpactType
-	0,  // 13: cityaqrpc.MapScaleRequest.Emission:type_name -> cityaqrpc.Emission
-	2,  // 14: cityaqrpc.CityAQ.Cities:input_type -> cityaqrpc.CitiesRequest
-	4,  // 15: cityaqrpc.CityAQ.CityGeometry:input_type -> cityaqrpc.CityGeometryRequest
-	9,  // 16: cityaqrpc.CityAQ.GriddedEmissions:input_type -> cityaqrpc.GriddedEmissionsRequest
-	17, // 17: cityaqrpc.CityAQ.EmissionsGridBounds:input_type -> cityaqrpc.EmissionsGridBoundsRequest
-	11, // 18: cityaqrpc.CityAQ.GriddedConcentrations:input_type -> cityaqrpc.GriddedConcentrationsRequest
-	19, // 19: cityaqrpc.CityAQ.MapScale:input_type -> cityaqrpc.MapScaleRequest
-	13, // 20: cityaqrpc.CityAQ.GriddedPopulation:input_type -> cityaqrpc.GriddedPopulationRequest
-	15, // 21: cityaqrpc.CityAQ.ImpactSummary:input_type -> cityaqrpc.ImpactSummaryRequest
-	3,  // 22: cityaqrpc.CityAQ.Cities:output_type -> cityaqrpc.CitiesResponse
-	5,  // 23: cityaqrpc.CityAQ.CityGeometry:output_type -> cityaqrpc.CityGeometryResponse
-	10, // 24: cityaqrpc.CityAQ.GriddedEmissions:output_type -> cityaqrpc.GriddedEmissionsResponse
-	18, // 25: cityaqrpc.CityAQ.EmissionsGridBounds:output_type -> cityaqrpc.EmissionsGridBoundsResponse
-	12, // 26: cityaqrpc.CityAQ.GriddedConcentrations:output_type -> cityaqrpc.GriddedConcentrationsResponse
-	20, // 27: cityaqrpc.CityAQ.MapScale:output_type -> cityaqrpc.MapScaleResponse
-	14, // 28: cityaqrpc.CityAQ.GriddedPopulation:output_type -> cityaqrpc.GriddedPopulationResponse
-	16, // 29: cityaqrpc.CityAQ.ImpactSummary:output_type -> cityaqrpc.ImpactSummaryResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
-}
-
-func init() { file_cityaq_proto_init() }
-func file_cityaq_proto_init() {
-	if File_cityaq_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cityaq_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CitiesRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GriddedConcentrationsResponse.ProtoReflect.Descriptor instead.
+func (*GriddedConcentrationsResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GriddedConcentrationsResponse) GetPolygons() []*Polygon {
+	if x != nil {
+		return x.Polygons
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetConcentrations() []float64 {
+	if x != nil {
+		return x.Concentrations
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPrimaryPM25() []float64 {
+	if x != nil {
+		return x.PrimaryPM25
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPNH4() []float64 {
+	if x != nil {
+		return x.PNH4
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPNO3() []float64 {
+	if x != nil {
+		return x.PNO3
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPSO4() []float64 {
+	if x != nil {
+		return x.PSO4
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetSOA() []float64 {
+	if x != nil {
+		return x.SOA
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetBaselineTotalPM25() []float64 {
+	if x != nil {
+		return x.BaselineTotalPM25
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetGrid() *CompactGrid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPrimaryPM25Values() []float32 {
+	if x != nil {
+		return x.PrimaryPM25Values
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPNH4Values() []float32 {
+	if x != nil {
+		return x.PNH4Values
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPNO3Values() []float32 {
+	if x != nil {
+		return x.PNO3Values
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetPSO4Values() []float32 {
+	if x != nil {
+		return x.PSO4Values
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetSOAValues() []float32 {
+	if x != nil {
+		return x.SOAValues
+	}
+	return nil
+}
+
+func (x *GriddedConcentrationsResponse) GetBaselineTotalPM25Values() []float32 {
+	if x != nil {
+		return x.BaselineTotalPM25Values
+	}
+	return nil
+}
+
+// EmissionScenario specifies a source that emits a separate amount of
+// each pollutant.
+type EmissionScenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emissions []*PollutantEmission `protobuf:"bytes,1,rep,name=Emissions,proto3" json:"Emissions,omitempty"`
+}
+
+func (x *EmissionScenario) Reset() {
+	*x = EmissionScenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionScenario) ProtoMessage() {}
+
+func (x *EmissionScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionScenario.ProtoReflect.Descriptor instead.
+func (*EmissionScenario) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EmissionScenario) GetEmissions() []*PollutantEmission {
+	if x != nil {
+		return x.Emissions
+	}
+	return nil
+}
+
+// PollutantEmission specifies the emissions rate of a single pollutant.
+type PollutantEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emission Emission `protobuf:"varint,1,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Amount is the amount of emissions, in units of Unit.
+	// If unset, an amount of 1 Unit is assumed.
+	Amount float64 `protobuf:"fixed64,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Unit is the unit of Amount, for example "t/yr", "kg/day", or "kt/yr".
+	// If unset, "kt/yr" is assumed.
+	Unit string `protobuf:"bytes,3,opt,name=Unit,proto3" json:"Unit,omitempty"`
+}
+
+func (x *PollutantEmission) Reset() {
+	*x = PollutantEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollutantEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollutantEmission) ProtoMessage() {}
+
+func (x *PollutantEmission) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollutantEmission.ProtoReflect.Descriptor instead.
+func (*PollutantEmission) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PollutantEmission) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *PollutantEmission) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PollutantEmission) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type GriddedPopulationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding GridEncoding `protobuf:"varint,4,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
+}
+
+func (x *GriddedPopulationRequest) Reset() {
+	*x = GriddedPopulationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GriddedPopulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GriddedPopulationRequest) ProtoMessage() {}
+
+func (x *GriddedPopulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GriddedPopulationRequest.ProtoReflect.Descriptor instead.
+func (*GriddedPopulationRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GriddedPopulationRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *GriddedPopulationRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *GriddedPopulationRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *GriddedPopulationRequest) GetEncoding() GridEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedPopulationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Polygons   []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	Population []float64  `protobuf:"fixed64,2,rep,packed,name=Population,proto3" json:"Population,omitempty"`
+	// MortalityRate is the all-cause mortality rate,
+	// in deaths per 100,000 people per year.
+	MortalityRate []float64 `protobuf:"fixed64,3,rep,packed,name=MortalityRate,proto3" json:"MortalityRate,omitempty"`
+	// Grid, Values, and MortalityRateValues replace Polygons,
+	// Population, and MortalityRate when the request specifies
+	// CompactEncoding.
+	Grid                *CompactGrid `protobuf:"bytes,4,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values              []float32    `protobuf:"fixed32,5,rep,packed,name=Values,proto3" json:"Values,omitempty"`
+	MortalityRateValues []float32    `protobuf:"fixed32,6,rep,packed,name=MortalityRateValues,proto3" json:"MortalityRateValues,omitempty"`
+}
+
+func (x *GriddedPopulationResponse) Reset() {
+	*x = GriddedPopulationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GriddedPopulationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GriddedPopulationResponse) ProtoMessage() {}
+
+func (x *GriddedPopulationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GriddedPopulationResponse.ProtoReflect.Descriptor instead.
+func (*GriddedPopulationResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GriddedPopulationResponse) GetPolygons() []*Polygon {
+	if x != nil {
+		return x.Polygons
+	}
+	return nil
+}
+
+func (x *GriddedPopulationResponse) GetPopulation() []float64 {
+	if x != nil {
+		return x.Population
+	}
+	return nil
+}
+
+func (x *GriddedPopulationResponse) GetMortalityRate() []float64 {
+	if x != nil {
+		return x.MortalityRate
+	}
+	return nil
+}
+
+func (x *GriddedPopulationResponse) GetGrid() *CompactGrid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *GriddedPopulationResponse) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *GriddedPopulationResponse) GetMortalityRateValues() []float32 {
+	if x != nil {
+		return x.MortalityRateValues
+	}
+	return nil
+}
+
+// CompactGrid describes the cells of a grid more compactly than
+// a list of polygons. Cells are either described by the regular
+// lattice specified by X0, Y0, Dx, Dy, Nx, and Ny, or, if Nx is zero,
+// individually by CellBounds.
+type CompactGrid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// X0 and Y0 are the coordinates of the lower-left corner of the
+	// grid, Dx and Dy are the width and height of each cell, and Nx and
+	// Ny are the numbers of columns and rows. Cells are ordered by row,
+	// starting with the lower-left cell.
+	X0 float64 `protobuf:"fixed64,1,opt,name=X0,proto3" json:"X0,omitempty"`
+	Y0 float64 `protobuf:"fixed64,2,opt,name=Y0,proto3" json:"Y0,omitempty"`
+	Dx float64 `protobuf:"fixed64,3,opt,name=Dx,proto3" json:"Dx,omitempty"`
+	Dy float64 `protobuf:"fixed64,4,opt,name=Dy,proto3" json:"Dy,omitempty"`
+	Nx int32   `protobuf:"varint,5,opt,name=Nx,proto3" json:"Nx,omitempty"`
+	Ny int32   `protobuf:"varint,6,opt,name=Ny,proto3" json:"Ny,omitempty"`
+	// CellBounds holds the MinX, MinY, MaxX, and MaxY coordinates
+	// of each rectangular cell in turn, for grids such as the InMAP
+	// variable-resolution grid that are not regular.
+	CellBounds []float64 `protobuf:"fixed64,7,rep,packed,name=CellBounds,proto3" json:"CellBounds,omitempty"`
+}
+
+func (x *CompactGrid) Reset() {
+	*x = CompactGrid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactGrid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactGrid) ProtoMessage() {}
+
+func (x *CompactGrid) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactGrid.ProtoReflect.Descriptor instead.
+func (*CompactGrid) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompactGrid) GetX0() float64 {
+	if x != nil {
+		return x.X0
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetY0() float64 {
+	if x != nil {
+		return x.Y0
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetDx() float64 {
+	if x != nil {
+		return x.Dx
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetDy() float64 {
+	if x != nil {
+		return x.Dy
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetNx() int32 {
+	if x != nil {
+		return x.Nx
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetNy() int32 {
+	if x != nil {
+		return x.Ny
+	}
+	return 0
+}
+
+func (x *CompactGrid) GetCellBounds() []float64 {
+	if x != nil {
+		return x.CellBounds
+	}
+	return nil
+}
+
+type ImpactSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// TotalPM25 specifies that the summary should be calculated for
+	// total PM2.5 resulting from emissions of every precursor, rather
+	// than only the PM2.5 species that corresponds to Emission.
+	TotalPM25 bool `protobuf:"varint,6,opt,name=TotalPM25,proto3" json:"TotalPM25,omitempty"`
+	// ConcentrationResponse specifies the concentration-response function
+	// used to calculate premature deaths. If unset, Krewski2009 is used.
+	ConcentrationResponse ConcentrationResponse `protobuf:"varint,7,opt,name=ConcentrationResponse,proto3,enum=cityaqrpc.ConcentrationResponse" json:"ConcentrationResponse,omitempty"`
+	// Scenario optionally specifies a source that emits several pollutants
+	// at once. If it is set, the summary is calculated for total PM2.5
+	// resulting from the combined emissions of all of the pollutants in
+	// the scenario, and Emission, EmissionAmount, EmissionUnit and
+	// TotalPM25 are ignored.
+	Scenario *EmissionScenario `protobuf:"bytes,8,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+}
+
+func (x *ImpactSummaryRequest) Reset() {
+	*x = ImpactSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImpactSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpactSummaryRequest) ProtoMessage() {}
+
+func (x *ImpactSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpactSummaryRequest.ProtoReflect.Descriptor instead.
+func (*ImpactSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImpactSummaryRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *ImpactSummaryRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ImpactSummaryRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *ImpactSummaryRequest) GetEmissionAmount() float64 {
+	if x != nil {
+		return x.EmissionAmount
+	}
+	return 0
+}
+
+func (x *ImpactSummaryRequest) GetEmissionUnit() string {
+	if x != nil {
+		return x.EmissionUnit
+	}
+	return ""
+}
+
+func (x *ImpactSummaryRequest) GetTotalPM25() bool {
+	if x != nil {
+		return x.TotalPM25
+	}
+	return false
+}
+
+func (x *ImpactSummaryRequest) GetConcentrationResponse() ConcentrationResponse {
+	if x != nil {
+		return x.ConcentrationResponse
+	}
+	return ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE
+}
+
+func (x *ImpactSummaryRequest) GetScenario() *EmissionScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type ImpactSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Population is the total population.
+	Population float64 `protobuf:"fixed64,1,opt,name=Population,proto3" json:"Population,omitempty"`
+	// CityPopulation is the total population in the city.
+	CityPopulation float64 `protobuf:"fixed64,2,opt,name=CityPopulation,proto3" json:"CityPopulation,omitempty"`
+	// CityExposure is the population-weighted average
+	// concentration in the city.
+	CityExposure float64 `protobuf:"fixed64,3,opt,name=CityExposure,proto3" json:"CityExposure,omitempty"`
+	// TotalExposure is the overall population-weighted average
+	// concentration.
+	TotalExposure float64 `protobuf:"fixed64,4,opt,name=TotalExposure,proto3" json:"TotalExposure,omitempty"`
+	// CityIF is the intake fraction in the city.
+	CityIF float64 `protobuf:"fixed64,5,opt,name=CityIF,proto3" json:"CityIF,omitempty"`
+	// TotalIF is the total intake fraction.
+	TotalIF float64 `protobuf:"fixed64,6,opt,name=TotalIF,proto3" json:"TotalIF,omitempty"`
+	// CityDeaths is the number of premature deaths per year in
+	// the city caused by the emissions.
+	CityDeaths float64 `protobuf:"fixed64,7,opt,name=CityDeaths,proto3" json:"CityDeaths,omitempty"`
+	// TotalDeaths is the total number of premature deaths per year
+	// caused by the emissions.
+	TotalDeaths float64 `protobuf:"fixed64,8,opt,name=TotalDeaths,proto3" json:"TotalDeaths,omitempty"`
+}
+
+func (x *ImpactSummaryResponse) Reset() {
+	*x = ImpactSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImpactSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpactSummaryResponse) ProtoMessage() {}
+
+func (x *ImpactSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpactSummaryResponse.ProtoReflect.Descriptor instead.
+func (*ImpactSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImpactSummaryResponse) GetPopulation() float64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetCityPopulation() float64 {
+	if x != nil {
+		return x.CityPopulation
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetCityExposure() float64 {
+	if x != nil {
+		return x.CityExposure
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetTotalExposure() float64 {
+	if x != nil {
+		return x.TotalExposure
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetCityIF() float64 {
+	if x != nil {
+		return x.CityIF
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetTotalIF() float64 {
+	if x != nil {
+		return x.TotalIF
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetCityDeaths() float64 {
+	if x != nil {
+		return x.CityDeaths
+	}
+	return 0
+}
+
+func (x *ImpactSummaryResponse) GetTotalDeaths() float64 {
+	if x != nil {
+		return x.TotalDeaths
+	}
+	return 0
+}
+
+type DamagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// ConcentrationResponse specifies the concentration-response function
+	// used to calculate premature deaths. If unset, Krewski2009 is used.
+	ConcentrationResponse ConcentrationResponse `protobuf:"varint,4,opt,name=ConcentrationResponse,proto3,enum=cityaqrpc.ConcentrationResponse" json:"ConcentrationResponse,omitempty"`
+	// VSL is the value of a statistical life, in dollars, in the
+	// reference country.
+	VSL float64 `protobuf:"fixed64,5,opt,name=VSL,proto3" json:"VSL,omitempty"`
+	// IncomeElasticity is the income elasticity of the VSL. It is used
+	// to adjust the VSL to the country that the city is in. If it is
+	// zero, the VSL is not adjusted. Servers are not configured with
+	// income data by default, so callers that set it should usually
+	// also set ReferenceIncome and CountryIncomes.
+	IncomeElasticity float64 `protobuf:"fixed64,6,opt,name=IncomeElasticity,proto3" json:"IncomeElasticity,omitempty"`
+	// ReferenceIncome is the per-capita income in the reference country,
+	// in US dollars. If it is unset, the server's income data for its
+	// reference country is used.
+	ReferenceIncome float64 `protobuf:"fixed64,7,opt,name=ReferenceIncome,proto3" json:"ReferenceIncome,omitempty"`
+	// CountryIncomes optionally holds the per-capita income in each
+	// country, in US dollars, keyed by country name. The server's income
+	// data is used for the country that the city is in if it isn't
+	// included.
+	CountryIncomes map[string]float64 `protobuf:"bytes,8,rep,name=CountryIncomes,proto3" json:"CountryIncomes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// DiscountRate is the annual rate, for example 0.03, used to discount
+	// deaths that occur in the years after exposure.
+	DiscountRate float64 `protobuf:"fixed64,9,opt,name=DiscountRate,proto3" json:"DiscountRate,omitempty"`
+}
+
+func (x *DamagesRequest) Reset() {
+	*x = DamagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DamagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamagesRequest) ProtoMessage() {}
+
+func (x *DamagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamagesRequest.ProtoReflect.Descriptor instead.
+func (*DamagesRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DamagesRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *DamagesRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *DamagesRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *DamagesRequest) GetConcentrationResponse() ConcentrationResponse {
+	if x != nil {
+		return x.ConcentrationResponse
+	}
+	return ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE
+}
+
+func (x *DamagesRequest) GetVSL() float64 {
+	if x != nil {
+		return x.VSL
+	}
+	return 0
+}
+
+func (x *DamagesRequest) GetIncomeElasticity() float64 {
+	if x != nil {
+		return x.IncomeElasticity
+	}
+	return 0
+}
+
+func (x *DamagesRequest) GetReferenceIncome() float64 {
+	if x != nil {
+		return x.ReferenceIncome
+	}
+	return 0
+}
+
+func (x *DamagesRequest) GetCountryIncomes() map[string]float64 {
+	if x != nil {
+		return x.CountryIncomes
+	}
+	return nil
+}
+
+func (x *DamagesRequest) GetDiscountRate() float64 {
+	if x != nil {
+		return x.DiscountRate
+	}
+	return 0
+}
+
+type DamagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Country is the name of the country that the city is in.
+	Country string `protobuf:"bytes,1,opt,name=Country,proto3" json:"Country,omitempty"`
+	// VSL is the value of a statistical life, in dollars, after
+	// adjusting for income in Country.
+	VSL float64 `protobuf:"fixed64,2,opt,name=VSL,proto3" json:"VSL,omitempty"`
+	// CityDamages is the monetized value of premature deaths in the
+	// city, in dollars per tonne of emissions.
+	CityDamages float64 `protobuf:"fixed64,3,opt,name=CityDamages,proto3" json:"CityDamages,omitempty"`
+	// TotalDamages is the total monetized value of premature deaths,
+	// in dollars per tonne of emissions.
+	TotalDamages float64 `protobuf:"fixed64,4,opt,name=TotalDamages,proto3" json:"TotalDamages,omitempty"`
+}
+
+func (x *DamagesResponse) Reset() {
+	*x = DamagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DamagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamagesResponse) ProtoMessage() {}
+
+func (x *DamagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamagesResponse.ProtoReflect.Descriptor instead.
+func (*DamagesResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DamagesResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *DamagesResponse) GetVSL() float64 {
+	if x != nil {
+		return x.VSL
+	}
+	return 0
+}
+
+func (x *DamagesResponse) GetCityDamages() float64 {
+	if x != nil {
+		return x.CityDamages
+	}
+	return 0
+}
+
+func (x *DamagesResponse) GetTotalDamages() float64 {
+	if x != nil {
+		return x.TotalDamages
+	}
+	return 0
+}
+
+type JobProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Emission is included for consistency with
+	// GriddedConcentrationsRequest. The concentrations resulting from
+	// all emissions from a source type are calculated by the same job.
+	Emission Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Scenario specifies the emissions scenario, if any, that the job
+	// is modeling.
+	Scenario *EmissionScenario `protobuf:"bytes,4,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+}
+
+func (x *JobProgressRequest) Reset() {
+	*x = JobProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobProgressRequest) ProtoMessage() {}
+
+func (x *JobProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobProgressRequest.ProtoReflect.Descriptor instead.
+func (*JobProgressRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JobProgressRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *JobProgressRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *JobProgressRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *JobProgressRequest) GetScenario() *EmissionScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type JobProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State JobState `protobuf:"varint,1,opt,name=State,proto3,enum=cityaqrpc.JobState" json:"State,omitempty"`
+	// Message holds additional information about the state, if any.
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *JobProgressResponse) Reset() {
+	*x = JobProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobProgressResponse) ProtoMessage() {}
+
+func (x *JobProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobProgressResponse.ProtoReflect.Descriptor instead.
+func (*JobProgressResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JobProgressResponse) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_UNKNOWN_JOBSTATE
+}
+
+func (x *JobProgressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitConcentrationJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario specifies the emissions scenario, if any, to model.
+	Scenario *EmissionScenario `protobuf:"bytes,3,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+}
+
+func (x *SubmitConcentrationJobRequest) Reset() {
+	*x = SubmitConcentrationJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitConcentrationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitConcentrationJobRequest) ProtoMessage() {}
+
+func (x *SubmitConcentrationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitConcentrationJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitConcentrationJobRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitConcentrationJobRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *SubmitConcentrationJobRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *SubmitConcentrationJobRequest) GetScenario() *EmissionScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID uniquely identifies the job.
+	ID         string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CityName   string   `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	State      JobState `protobuf:"varint,4,opt,name=State,proto3,enum=cityaqrpc.JobState" json:"State,omitempty"`
+	// Message holds the most recent status message for the job, if any.
+	Message string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Updated is the time of the most recent change in State,
+	// in seconds since the Unix epoch.
+	Updated int64 `protobuf:"varint,6,opt,name=Updated,proto3" json:"Updated,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Job) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Job) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *Job) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_UNKNOWN_JOBSTATE
+}
+
+func (x *Job) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Job) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetJobRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelJobRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{34}
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type ListCachedResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CityName and SourceType, if specified, limit the results to those
+	// for the given city and source type. CityName may be the name or
+	// the ID of the city.
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *ListCachedResultsRequest) Reset() {
+	*x = ListCachedResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCachedResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachedResultsRequest) ProtoMessage() {}
+
+func (x *ListCachedResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachedResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListCachedResultsRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCachedResultsRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *ListCachedResultsRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type ListCachedResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*CachedResultInfo `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *ListCachedResultsResponse) Reset() {
+	*x = ListCachedResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCachedResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachedResultsResponse) ProtoMessage() {}
+
+func (x *ListCachedResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachedResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListCachedResultsResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCachedResultsResponse) GetResults() []*CachedResultInfo {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CachedResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *CachedResultRequest) Reset() {
+	*x = CachedResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachedResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachedResultRequest) ProtoMessage() {}
+
+func (x *CachedResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachedResultRequest.ProtoReflect.Descriptor instead.
+func (*CachedResultRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CachedResultRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CachedResultInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key is the cache key of the result, which is also the ID of
+	// the job that created it.
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	// CityName and SourceType are empty for results that were created
+	// by earlier versions of CityAQ.
+	CityName   string `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario is true if the result is for an emissions scenario.
+	Scenario bool `protobuf:"varint,4,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	// Size is the size of the result in bytes.
+	Size int64 `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	// Created is the time the result was created, in seconds since
+	// the Unix epoch.
+	Created int64 `protobuf:"varint,6,opt,name=Created,proto3" json:"Created,omitempty"`
+	// InMAPVersion is the version of InMAP used to create the result.
+	InMAPVersion string `protobuf:"bytes,7,opt,name=InMAPVersion,proto3" json:"InMAPVersion,omitempty"`
+	// CityID is the ID of the city. It is empty for results that were
+	// created by earlier versions of CityAQ.
+	CityID string `protobuf:"bytes,8,opt,name=CityID,proto3" json:"CityID,omitempty"`
+}
+
+func (x *CachedResultInfo) Reset() {
+	*x = CachedResultInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachedResultInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachedResultInfo) ProtoMessage() {}
+
+func (x *CachedResultInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachedResultInfo.ProtoReflect.Descriptor instead.
+func (*CachedResultInfo) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CachedResultInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CachedResultInfo) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *CachedResultInfo) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *CachedResultInfo) GetScenario() bool {
+	if x != nil {
+		return x.Scenario
+	}
+	return false
+}
+
+func (x *CachedResultInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CachedResultInfo) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *CachedResultInfo) GetInMAPVersion() string {
+	if x != nil {
+		return x.InMAPVersion
+	}
+	return ""
+}
+
+func (x *CachedResultInfo) GetCityID() string {
+	if x != nil {
+		return x.CityID
+	}
+	return ""
+}
+
+type InvalidateCachedResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results matching all of the specified fields are deleted. At least
+	// one field must be specified. CityName may be the name or the ID of
+	// the city.
+	Key        string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	CityName   string `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *InvalidateCachedResultsRequest) Reset() {
+	*x = InvalidateCachedResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCachedResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCachedResultsRequest) ProtoMessage() {}
+
+func (x *InvalidateCachedResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCachedResultsRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCachedResultsRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InvalidateCachedResultsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *InvalidateCachedResultsRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *InvalidateCachedResultsRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type InvalidateCachedResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keys holds the keys of the deleted results.
+	Keys []string `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (x *InvalidateCachedResultsResponse) Reset() {
+	*x = InvalidateCachedResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCachedResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCachedResultsResponse) ProtoMessage() {}
+
+func (x *InvalidateCachedResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCachedResultsResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCachedResultsResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InvalidateCachedResultsResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type EmissionsGridBoundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *EmissionsGridBoundsRequest) Reset() {
+	*x = EmissionsGridBoundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsGridBoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsGridBoundsRequest) ProtoMessage() {}
+
+func (x *EmissionsGridBoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsGridBoundsRequest.ProtoReflect.Descriptor instead.
+func (*EmissionsGridBoundsRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EmissionsGridBoundsRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *EmissionsGridBoundsRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type EmissionsGridBoundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min *Point `protobuf:"bytes,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max *Point `protobuf:"bytes,2,opt,name=Max,proto3" json:"Max,omitempty"`
+}
+
+func (x *EmissionsGridBoundsResponse) Reset() {
+	*x = EmissionsGridBoundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsGridBoundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsGridBoundsResponse) ProtoMessage() {}
+
+func (x *EmissionsGridBoundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsGridBoundsResponse.ProtoReflect.Descriptor instead.
+func (*EmissionsGridBoundsResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EmissionsGridBoundsResponse) GetMin() *Point {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *EmissionsGridBoundsResponse) GetMax() *Point {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type MapScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName   string     `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	ImpactType ImpactType `protobuf:"varint,2,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
+	Emission   Emission   `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	SourceType string     `protobuf:"bytes,4,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario optionally specifies a source that emits several
+	// pollutants at once. If it is set, the scale of concentrations is
+	// for total PM2.5 resulting from the combined emissions, as for
+	// GriddedConcentrationsRequest.Scenario. It is ignored for emissions.
+	Scenario *EmissionScenario `protobuf:"bytes,5,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+}
+
+func (x *MapScaleRequest) Reset() {
+	*x = MapScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapScaleRequest) ProtoMessage() {}
+
+func (x *MapScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapScaleRequest.ProtoReflect.Descriptor instead.
+func (*MapScaleRequest) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MapScaleRequest) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *MapScaleRequest) GetImpactType() ImpactType {
+	if x != nil {
+		return x.ImpactType
+	}
+	return ImpactType_UNKNOWN_IMPACTTYPE
+}
+
+func (x *MapScaleRequest) GetEmission() Emission {
+	if x != nil {
+		return x.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (x *MapScaleRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *MapScaleRequest) GetScenario() *EmissionScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type MapScaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min   float64 `protobuf:"fixed64,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max   float64 `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	CutPt float64 `protobuf:"fixed64,3,opt,name=CutPt,proto3" json:"CutPt,omitempty"`
+}
+
+func (x *MapScaleResponse) Reset() {
+	*x = MapScaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cityaq_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapScaleResponse) ProtoMessage() {}
+
+func (x *MapScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityaq_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapScaleResponse.ProtoReflect.Descriptor instead.
+func (*MapScaleResponse) Descriptor() ([]byte, []int) {
+	return file_cityaq_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MapScaleResponse) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MapScaleResponse) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MapScaleResponse) GetCutPt() float64 {
+	if x != nil {
+		return x.CutPt
+	}
+	return 0
+}
+
+var File_cityaq_proto protoreflect.FileDescriptor
+
+var file_cityaq_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52,
+	0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x74,
+	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x43, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x43, 0x65, 0x6e, 0x74, 0x72,
+	0x6f, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x52, 0x06, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x65, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x41, 0x72, 0x65, 0x61, 0x12, 0x3f, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x4d,
+	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x22,
+	0x10, 0x0a, 0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x74,
+	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x47, 0x55, 0x47, 0x72, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x45, 0x47, 0x55, 0x47, 0x72, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x4f,
+	0x53, 0x4d, 0x54, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x53, 0x4d, 0x54, 0x61, 0x67, 0x52,
+	0x07, 0x4f, 0x53, 0x4d, 0x54, 0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x06, 0x4f, 0x53, 0x4d, 0x54,
+	0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a,
+	0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x0a,
+	0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x0a, 0x49,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x13,
+	0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x46, 0x0a, 0x14, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74, 0x79,
+	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x30, 0x0a, 0x04, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x28, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x05, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x58, 0x12, 0x0c, 0x0a, 0x01, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x59,
+	0x22, 0x87, 0x02, 0x0a, 0x17, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74,
+	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x47,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74, 0x79,
+	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x47, 0x72, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x47, 0x72, 0x69, 0x64, 0x52, 0x04, 0x47, 0x72, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x1c, 0x47, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55,
+	0x6e, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x12, 0x33, 0x0a, 0x08, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0xd0, 0x04, 0x0a, 0x1d, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x50, 0x4d, 0x32, 0x35, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x4d, 0x32, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x4e, 0x48, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x50, 0x4e, 0x48, 0x34, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x4e, 0x4f, 0x33, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x50,
+	0x4e, 0x4f, 0x33, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x53, 0x4f, 0x34, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x04, 0x50, 0x53, 0x4f, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x4f, 0x41, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x53, 0x4f, 0x41, 0x12, 0x2c, 0x0a, 0x11, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x11, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x12, 0x2a, 0x0a, 0x04, 0x47, 0x72, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x47, 0x72, 0x69, 0x64, 0x52, 0x04, 0x47,
+	0x72, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x4d, 0x32, 0x35, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x11, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50,
+	0x4d, 0x32, 0x35, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x4e, 0x48,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0a, 0x50,
+	0x4e, 0x48, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x4e, 0x4f,
+	0x33, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0a, 0x50,
+	0x4e, 0x4f, 0x33, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x53, 0x4f,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0a, 0x50,
+	0x53, 0x4f, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x4f, 0x41,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x02, 0x52, 0x09, 0x53, 0x4f,
+	0x41, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x42, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x02, 0x52, 0x17, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d,
+	0x32, 0x35, 0x22, 0x4e, 0x0a, 0x10, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x69, 0x74, 0x79,
+	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x75, 0x74, 0x61, 0x6e, 0x74, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x70, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x6c, 0x75, 0x74, 0x61, 0x6e, 0x74, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79,
+	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x55, 0x6e, 0x69, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64,
+	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a,
+	0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69,
+	0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x87, 0x02, 0x0a, 0x19, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0d, 0x4d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x47, 0x72, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x47, 0x72, 0x69, 0x64, 0x52, 0x04, 0x47,
+	0x72, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x4d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02, 0x52, 0x13, 0x4d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x8d, 0x01,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x47, 0x72, 0x69, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x58, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x58, 0x30, 0x12, 0x0e, 0x0a,
+	0x02, 0x59, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x59, 0x30, 0x12, 0x0e, 0x0a,
+	0x02, 0x44, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x44, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x44, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x44, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x4e, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x4e, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x4e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x4e, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x0a, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xfe, 0x02,
+	0x0a, 0x14, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x4d, 0x32, 0x35, 0x12, 0x56, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x15,
+	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22, 0x9d,
+	0x02, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x70, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x50, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79,
+	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x43, 0x69, 0x74, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x43, 0x69, 0x74, 0x79, 0x45, 0x78, 0x70, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x70,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69,
+	0x74, 0x79, 0x49, 0x46, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x43, 0x69, 0x74, 0x79,
+	0x49, 0x46, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x46, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x46, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x69, 0x74, 0x79, 0x44, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x43, 0x69, 0x74, 0x79, 0x44, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x74, 0x68, 0x73, 0x22, 0xfb,
+	0x03, 0x0a, 0x0e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a,
+	0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x56,
+	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x15, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x53, 0x4c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x56, 0x53, 0x4c, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x45, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x45, 0x6c, 0x61, 0x73, 0x74, 0x69,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x55,
+	0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x01, 0x0a,
+	0x0f, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x53,
+	0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x56, 0x53, 0x4c, 0x12, 0x20, 0x0a, 0x0b,
+	0x43, 0x69, 0x74, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x43, 0x69, 0x74, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22,
+	0x5a, 0x0a, 0x13, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1d,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x04, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0xe6, 0x01, 0x0a, 0x10, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x4d, 0x41, 0x50,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49,
+	0x6e, 0x4d, 0x41, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x43,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x22, 0x6e, 0x0a, 0x1e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x1f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x58, 0x0a, 0x1a, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x65, 0x0a, 0x1b, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x22, 0xee, 0x01, 0x0a, 0x0f,
+	0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x49,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22, 0x4c, 0x0a, 0x10,
+	0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x4d,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x4d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x75, 0x74, 0x50, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x43, 0x75, 0x74, 0x50, 0x74, 0x2a, 0x96, 0x01, 0x0a, 0x08, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x4a, 0x4f, 0x42, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65,
+	0x64, 0x10, 0x08, 0x2a, 0x4f, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4d, 0x32, 0x5f, 0x35, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x4e, 0x48, 0x33, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x78,
+	0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4f, 0x78, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x56,
+	0x4f, 0x43, 0x10, 0x05, 0x2a, 0x38, 0x0a, 0x0c, 0x47, 0x72, 0x69, 0x64, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x2a, 0x60,
+	0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x41, 0x43, 0x54, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x03,
+	0x2a, 0x55, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x43, 0x45, 0x4e, 0x54, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4b, 0x72, 0x65, 0x77, 0x73, 0x6b, 0x69, 0x32, 0x30, 0x30, 0x39, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x47, 0x45, 0x4d, 0x4d, 0x10, 0x02, 0x32, 0xa5, 0x0e, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x79,
+	0x41, 0x51, 0x12, 0x3f, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x74, 0x79,
+	0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x69, 0x74, 0x79, 0x47,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x47, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64,
+	0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x66, 0x0a, 0x13, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69,
+	0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69,
+	0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x69, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x15, 0x47, 0x72, 0x69, 0x64,
+	0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x69, 0x74,
+	0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x08,
+	0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x61, 0x70, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65,
+	0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x69,
+	0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x64, 0x65, 0x64, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x54, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1f, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x19, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0b, 0x4a, 0x6f, 0x62,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61,
+	0x71, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71,
+	0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x16, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70,
+	0x63, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f,
+	0x62, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12,
+	0x1a, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69,
+	0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x0b, 0x5a, 0x09, 0x63, 0x69, 0x74, 0x79, 0x61, 0x71, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cityaq_proto_rawDescOnce sync.Once
+	file_cityaq_proto_rawDescData = file_cityaq_proto_rawDesc
+)
+
+func file_cityaq_proto_rawDescGZIP() []byte {
+	file_cityaq_proto_rawDescOnce.Do(func() {
+		file_cityaq_proto_rawDescData = protoimpl.X.CompressGZIP(file_cityaq_proto_rawDescData)
+	})
+	return file_cityaq_proto_rawDescData
+}
+
+var file_cityaq_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_cityaq_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_cityaq_proto_goTypes = []interface{}{
+	(JobState)(0),                           // 0: cityaqrpc.JobState
+	(Emission)(0),                           // 1: cityaqrpc.Emission
+	(GridEncoding)(0),                       // 2: cityaqrpc.GridEncoding
+	(ImpactType)(0),                         // 3: cityaqrpc.ImpactType
+	(ConcentrationResponse)(0),              // 4: cityaqrpc.ConcentrationResponse
+	(*CitiesRequest)(nil),                   // 5: cityaqrpc.CitiesRequest
+	(*CitiesResponse)(nil),                  // 6: cityaqrpc.CitiesResponse
+	(*City)(nil),                            // 7: cityaqrpc.City
+	(*BoundingBox)(nil),                     // 8: cityaqrpc.BoundingBox
+	(*OptionsRequest)(nil),                  // 9: cityaqrpc.OptionsRequest
+	(*OptionsResponse)(nil),                 // 10: cityaqrpc.OptionsResponse
+	(*SourceTypeInfo)(nil),                  // 11: cityaqrpc.SourceTypeInfo
+	(*OSMTag)(nil),                          // 12: cityaqrpc.OSMTag
+	(*EmissionInfo)(nil),                    // 13: cityaqrpc.EmissionInfo
+	(*ImpactTypeInfo)(nil),                  // 14: cityaqrpc.ImpactTypeInfo
+	(*CityGeometryRequest)(nil),             // 15: cityaqrpc.CityGeometryRequest
+	(*CityGeometryResponse)(nil),            // 16: cityaqrpc.CityGeometryResponse
+	(*Polygon)(nil),                         // 17: cityaqrpc.Polygon
+	(*Path)(nil),                            // 18: cityaqrpc.Path
+	(*Point)(nil),                           // 19: cityaqrpc.Point
+	(*GriddedEmissionsRequest)(nil),         // 20: cityaqrpc.GriddedEmissionsRequest
+	(*GriddedEmissionsResponse)(nil),        // 21: cityaqrpc.GriddedEmissionsResponse
+	(*GriddedConcentrationsRequest)(nil),    // 22: cityaqrpc.GriddedConcentrationsRequest
+	(*GriddedConcentrationsResponse)(nil),   // 23: cityaqrpc.GriddedConcentrationsResponse
+	(*EmissionScenario)(nil),                // 24: cityaqrpc.EmissionScenario
+	(*PollutantEmission)(nil),               // 25: cityaqrpc.PollutantEmission
+	(*GriddedPopulationRequest)(nil),        // 26: cityaqrpc.GriddedPopulationRequest
+	(*GriddedPopulationResponse)(nil),       // 27: cityaqrpc.GriddedPopulationResponse
+	(*CompactGrid)(nil),                     // 28: cityaqrpc.CompactGrid
+	(*ImpactSummaryRequest)(nil),            // 29: cityaqrpc.ImpactSummaryRequest
+	(*ImpactSummaryResponse)(nil),           // 30: cityaqrpc.ImpactSummaryResponse
+	(*DamagesRequest)(nil),                  // 31: cityaqrpc.DamagesRequest
+	(*DamagesResponse)(nil),                 // 32: cityaqrpc.DamagesResponse
+	(*JobProgressRequest)(nil),              // 33: cityaqrpc.JobProgressRequest
+	(*JobProgressResponse)(nil),             // 34: cityaqrpc.JobProgressResponse
+	(*SubmitConcentrationJobRequest)(nil),   // 35: cityaqrpc.SubmitConcentrationJobRequest
+	(*Job)(nil),                             // 36: cityaqrpc.Job
+	(*GetJobRequest)(nil),                   // 37: cityaqrpc.GetJobRequest
+	(*CancelJobRequest)(nil),                // 38: cityaqrpc.CancelJobRequest
+	(*ListJobsRequest)(nil),                 // 39: cityaqrpc.ListJobsRequest
+	(*ListJobsResponse)(nil),                // 40: cityaqrpc.ListJobsResponse
+	(*ListCachedResultsRequest)(nil),        // 41: cityaqrpc.ListCachedResultsRequest
+	(*ListCachedResultsResponse)(nil),       // 42: cityaqrpc.ListCachedResultsResponse
+	(*CachedResultRequest)(nil),             // 43: cityaqrpc.CachedResultRequest
+	(*CachedResultInfo)(nil),                // 44: cityaqrpc.CachedResultInfo
+	(*InvalidateCachedResultsRequest)(nil),  // 45: cityaqrpc.InvalidateCachedResultsRequest
+	(*InvalidateCachedResultsResponse)(nil), // 46: cityaqrpc.InvalidateCachedResultsResponse
+	(*EmissionsGridBoundsRequest)(nil),      // 47: cityaqrpc.EmissionsGridBoundsRequest
+	(*EmissionsGridBoundsResponse)(nil),     // 48: cityaqrpc.EmissionsGridBoundsResponse
+	(*MapScaleRequest)(nil),                 // 49: cityaqrpc.MapScaleRequest
+	(*MapScaleResponse)(nil),                // 50: cityaqrpc.MapScaleResponse
+	nil,                                     // 51: cityaqrpc.City.DisplayNamesEntry
+	nil,                                     // 52: cityaqrpc.City.PropertiesEntry
+	nil,                                     // 53: cityaqrpc.DamagesRequest.CountryIncomesEntry
+}
+var file_cityaq_proto_depIdxs = []int32{
+	7,  // 0: cityaqrpc.CitiesResponse.Cities:type_name -> cityaqrpc.City
+	51, // 1: cityaqrpc.City.DisplayNames:type_name -> cityaqrpc.City.DisplayNamesEntry
+	19, // 2: cityaqrpc.City.Centroid:type_name -> cityaqrpc.Point
+	8,  // 3: cityaqrpc.City.Bounds:type_name -> cityaqrpc.BoundingBox
+	52, // 4: cityaqrpc.City.Properties:type_name -> cityaqrpc.City.PropertiesEntry
+	19, // 5: cityaqrpc.BoundingBox.Min:type_name -> cityaqrpc.Point
+	19, // 6: cityaqrpc.BoundingBox.Max:type_name -> cityaqrpc.Point
+	11, // 7: cityaqrpc.OptionsResponse.SourceTypes:type_name -> cityaqrpc.SourceTypeInfo
+	13, // 8: cityaqrpc.OptionsResponse.Emissions:type_name -> cityaqrpc.EmissionInfo
+	14, // 9: cityaqrpc.OptionsResponse.ImpactTypes:type_name -> cityaqrpc.ImpactTypeInfo
+	12, // 10: cityaqrpc.SourceTypeInfo.OSMTags:type_name -> cityaqrpc.OSMTag
+	1,  // 11: cityaqrpc.EmissionInfo.Emission:type_name -> cityaqrpc.Emission
+	3,  // 12: cityaqrpc.ImpactTypeInfo.ImpactType:type_name -> cityaqrpc.ImpactType
+	17, // 13: cityaqrpc.CityGeometryResponse.Polygons:type_name -> cityaqrpc.Polygon
+	18, // 14: cityaqrpc.Polygon.Paths:type_name -> cityaqrpc.Path
+	19, // 15: cityaqrpc.Path.Points:type_name -> cityaqrpc.Point
+	1,  // 16: cityaqrpc.GriddedEmissionsRequest.Emission:type_name -> cityaqrpc.Emission
+	2,  // 17: cityaqrpc.GriddedEmissionsRequest.Encoding:type_name -> cityaqrpc.GridEncoding
+	17, // 18: cityaqrpc.GriddedEmissionsResponse.Polygons:type_name -> cityaqrpc.Polygon
+	28, // 19: cityaqrpc.GriddedEmissionsResponse.Grid:type_name -> cityaqrpc.CompactGrid
+	1,  // 20: cityaqrpc.GriddedConcentrationsRequest.Emission:type_name -> cityaqrpc.Emission
+	24, // 21: cityaqrpc.GriddedConcentrationsRequest.Scenario:type_name -> cityaqrpc.EmissionScenario
+	2,  // 22: cityaqrpc.GriddedConcentrationsRequest.Encoding:type_name -> cityaqrpc.GridEncoding
+	17, // 23: cityaqrpc.GriddedConcentrationsResponse.Polygons:type_name -> cityaqrpc.Polygon
+	28, // 24: cityaqrpc.GriddedConcentrationsResponse.Grid:type_name -> cityaqrpc.CompactGrid
+	25, // 25: cityaqrpc.EmissionScenario.Emissions:type_name -> cityaqrpc.PollutantEmission
+	1,  // 26: cityaqrpc.PollutantEmission.Emission:type_name -> cityaqrpc.Emission
+	1,  // 27: cityaqrpc.GriddedPopulationRequest.Emission:type_name -> cityaqrpc.Emission
+	2,  // 28: cityaqrpc.GriddedPopulationRequest.Encoding:type_name -> cityaqrpc.GridEncoding
+	17, // 29: cityaqrpc.GriddedPopulationResponse.Polygons:type_name -> cityaqrpc.Polygon
+	28, // 30: cityaqrpc.GriddedPopulationResponse.Grid:type_name -> cityaqrpc.CompactGrid
+	1,  // 31: cityaqrpc.ImpactSummaryRequest.Emission:type_name -> cityaqrpc.Emission
+	4,  // 32: cityaqrpc.ImpactSummaryRequest.ConcentrationResponse:type_name -> cityaqrpc.ConcentrationResponse
+	24, // 33: cityaqrpc.ImpactSummaryRequest.Scenario:type_name -> cityaqrpc.EmissionScenario
+	1,  // 34: cityaqrpc.DamagesRequest.Emission:type_name -> cityaqrpc.Emission
+	4,  // 35: cityaqrpc.DamagesRequest.ConcentrationResponse:type_name -> cityaqrpc.ConcentrationResponse
+	53, // 36: cityaqrpc.DamagesRequest.CountryIncomes:type_name -> cityaqrpc.DamagesRequest.CountryIncomesEntry
+	1,  // 37: cityaqrpc.JobProgressRequest.Emission:type_name -> cityaqrpc.Emission
+	24, // 38: cityaqrpc.JobProgressRequest.Scenario:type_name -> cityaqrpc.EmissionScenario
+	0,  // 39: cityaqrpc.JobProgressResponse.State:type_name -> cityaqrpc.JobState
+	24, // 40: cityaqrpc.SubmitConcentrationJobRequest.Scenario:type_name -> cityaqrpc.EmissionScenario
+	0,  // 41: cityaqrpc.Job.State:type_name -> cityaqrpc.JobState
+	36, // 42: cityaqrpc.ListJobsResponse.Jobs:type_name -> cityaqrpc.Job
+	44, // 43: cityaqrpc.ListCachedResultsResponse.Results:type_name -> cityaqrpc.CachedResultInfo
+	19, // 44: cityaqrpc.EmissionsGridBoundsResponse.Min:type_name -> cityaqrpc.Point
+	19, // 45: cityaqrpc.EmissionsGridBoundsResponse.Max:type_name -> cityaqrpc.Point
+	3,  // 46: cityaqrpc.MapScaleRequest.ImpactType:type_name -> cityaqrpc.ImpactType
+	1,  // 47: cityaqrpc.MapScaleRequest.Emission:type_name -> cityaqrpc.Emission
+	24, // 48: cityaqrpc.MapScaleRequest.Scenario:type_name -> cityaqrpc.EmissionScenario
+	5,  // 49: cityaqrpc.CityAQ.Cities:input_type -> cityaqrpc.CitiesRequest
+	9,  // 50: cityaqrpc.CityAQ.Options:input_type -> cityaqrpc.OptionsRequest
+	15, // 51: cityaqrpc.CityAQ.CityGeometry:input_type -> cityaqrpc.CityGeometryRequest
+	20, // 52: cityaqrpc.CityAQ.GriddedEmissions:input_type -> cityaqrpc.GriddedEmissionsRequest
+	20, // 53: cityaqrpc.CityAQ.StreamGriddedEmissions:input_type -> cityaqrpc.GriddedEmissionsRequest
+	47, // 54: cityaqrpc.CityAQ.EmissionsGridBounds:input_type -> cityaqrpc.EmissionsGridBoundsRequest
+	22, // 55: cityaqrpc.CityAQ.GriddedConcentrations:input_type -> cityaqrpc.GriddedConcentrationsRequest
+	22, // 56: cityaqrpc.CityAQ.StreamGriddedConcentrations:input_type -> cityaqrpc.GriddedConcentrationsRequest
+	49, // 57: cityaqrpc.CityAQ.MapScale:input_type -> cityaqrpc.MapScaleRequest
+	26, // 58: cityaqrpc.CityAQ.GriddedPopulation:input_type -> cityaqrpc.GriddedPopulationRequest
+	26, // 59: cityaqrpc.CityAQ.StreamGriddedPopulation:input_type -> cityaqrpc.GriddedPopulationRequest
+	29, // 60: cityaqrpc.CityAQ.ImpactSummary:input_type -> cityaqrpc.ImpactSummaryRequest
+	31, // 61: cityaqrpc.CityAQ.Damages:input_type -> cityaqrpc.DamagesRequest
+	33, // 62: cityaqrpc.CityAQ.JobProgress:input_type -> cityaqrpc.JobProgressRequest
+	35, // 63: cityaqrpc.CityAQ.SubmitConcentrationJob:input_type -> cityaqrpc.SubmitConcentrationJobRequest
+	37, // 64: cityaqrpc.CityAQ.GetJob:input_type -> cityaqrpc.GetJobRequest
+	38, // 65: cityaqrpc.CityAQ.CancelJob:input_type -> cityaqrpc.CancelJobRequest
+	39, // 66: cityaqrpc.CityAQ.ListJobs:input_type -> cityaqrpc.ListJobsRequest
+	41, // 67: cityaqrpc.CityAQ.ListCachedResults:input_type -> cityaqrpc.ListCachedResultsRequest
+	43, // 68: cityaqrpc.CityAQ.CachedResult:input_type -> cityaqrpc.CachedResultRequest
+	45, // 69: cityaqrpc.CityAQ.InvalidateCachedResults:input_type -> cityaqrpc.InvalidateCachedResultsRequest
+	6,  // 70: cityaqrpc.CityAQ.Cities:output_type -> cityaqrpc.CitiesResponse
+	10, // 71: cityaqrpc.CityAQ.Options:output_type -> cityaqrpc.OptionsResponse
+	16, // 72: cityaqrpc.CityAQ.CityGeometry:output_type -> cityaqrpc.CityGeometryResponse
+	21, // 73: cityaqrpc.CityAQ.GriddedEmissions:output_type -> cityaqrpc.GriddedEmissionsResponse
+	21, // 74: cityaqrpc.CityAQ.StreamGriddedEmissions:output_type -> cityaqrpc.GriddedEmissionsResponse
+	48, // 75: cityaqrpc.CityAQ.EmissionsGridBounds:output_type -> cityaqrpc.EmissionsGridBoundsResponse
+	23, // 76: cityaqrpc.CityAQ.GriddedConcentrations:output_type -> cityaqrpc.GriddedConcentrationsResponse
+	23, // 77: cityaqrpc.CityAQ.StreamGriddedConcentrations:output_type -> cityaqrpc.GriddedConcentrationsResponse
+	50, // 78: cityaqrpc.CityAQ.MapScale:output_type -> cityaqrpc.MapScaleResponse
+	27, // 79: cityaqrpc.CityAQ.GriddedPopulation:output_type -> cityaqrpc.GriddedPopulationResponse
+	27, // 80: cityaqrpc.CityAQ.StreamGriddedPopulation:output_type -> cityaqrpc.GriddedPopulationResponse
+	30, // 81: cityaqrpc.CityAQ.ImpactSummary:output_type -> cityaqrpc.ImpactSummaryResponse
+	32, // 82: cityaqrpc.CityAQ.Damages:output_type -> cityaqrpc.DamagesResponse
+	34, // 83: cityaqrpc.CityAQ.JobProgress:output_type -> cityaqrpc.JobProgressResponse
+	36, // 84: cityaqrpc.CityAQ.SubmitConcentrationJob:output_type -> cityaqrpc.Job
+	36, // 85: cityaqrpc.CityAQ.GetJob:output_type -> cityaqrpc.Job
+	36, // 86: cityaqrpc.CityAQ.CancelJob:output_type -> cityaqrpc.Job
+	40, // 87: cityaqrpc.CityAQ.ListJobs:output_type -> cityaqrpc.ListJobsResponse
+	42, // 88: cityaqrpc.CityAQ.ListCachedResults:output_type -> cityaqrpc.ListCachedResultsResponse
+	44, // 89: cityaqrpc.CityAQ.CachedResult:output_type -> cityaqrpc.CachedResultInfo
+	46, // 90: cityaqrpc.CityAQ.InvalidateCachedResults:output_type -> cityaqrpc.InvalidateCachedResultsResponse
+	70, // [70:91] is the sub-list for method output_type
+	49, // [49:70] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
+}
+
+func init() { file_cityaq_proto_init() }
+func file_cityaq_proto_init() {
+	if File_cityaq_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cityaq_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*City); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoundingBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceTypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OSMTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpactTypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityGeometryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityGeometryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Polygon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedEmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedEmissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedConcentrationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedConcentrationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionScenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollutantEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedPopulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GriddedPopulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactGrid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpactSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpactSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DamagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,8 +4733,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CitiesResponse); i {
+		file_cityaq_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DamagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,8 +4745,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CityGeometryRequest); i {
+		file_cityaq_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,8 +4757,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CityGeometryResponse); i {
+		file_cityaq_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,8 +4769,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Polygon); i {
+		file_cityaq_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitConcentrationJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,8 +4781,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Path); i {
+		file_cityaq_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,8 +4793,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Point); i {
+		file_cityaq_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,8 +4805,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedEmissionsRequest); i {
+		file_cityaq_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1581,8 +4817,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedEmissionsResponse); i {
+		file_cityaq_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1593,8 +4829,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedConcentrationsRequest); i {
+		file_cityaq_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1605,8 +4841,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedConcentrationsResponse); i {
+		file_cityaq_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCachedResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1617,8 +4853,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedPopulationRequest); i {
+		file_cityaq_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCachedResultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1629,8 +4865,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GriddedPopulationResponse); i {
+		file_cityaq_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CachedResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1641,8 +4877,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImpactSummaryRequest); i {
+		file_cityaq_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CachedResultInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1653,8 +4889,8 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImpactSummaryResponse); i {
+		file_cityaq_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCachedResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1665,7 +4901,19 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_cityaq_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCachedResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cityaq_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmissionsGridBoundsRequest); i {
 			case 0:
 				return &v.state
@@ -1677,7 +4925,7 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_cityaq_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmissionsGridBoundsResponse); i {
 			case 0:
 				return &v.state
@@ -1689,7 +4937,7 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_cityaq_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MapScaleRequest); i {
 			case 0:
 				return &v.state
@@ -1701,7 +4949,7 @@ func file_cityaq_proto_init() {
 				return nil
 			}
 		}
-		file_cityaq_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_cityaq_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MapScaleResponse); i {
 			case 0:
 				return &v.state
@@ -1719,8 +4967,8 @@ func file_cityaq_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cityaq_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      5,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1749,100 +4997,350 @@ const _ = grpc.SupportPackageIsVersion6
 type CityAQClient interface {
 	// Cities returns the available cities.
 	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error)
+	// Options returns the source types, pollutants and impact types
+	// that can be requested, as configured on the server.
+	Options(ctx context.Context, in *OptionsRequest, opts ...grpc.CallOption) (*OptionsResponse, error)
 	// CityGeometry returns the boundary of the specified city.
 	CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error)
 	// GriddedEmissions returns the distribution within the city of
-	// 1 kilotonne of emissions.
+	// the requested amount of emissions (by default 1 kilotonne).
 	GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error)
+	// StreamGriddedEmissions returns the same information as
+	// GriddedEmissions, split into messages that each hold a subset of
+	// the grid cells, in order. When compact encoding is requested, a
+	// regular grid is only described by the first message, and otherwise
+	// each message holds the CellBounds of its own cells.
+	StreamGriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedEmissionsClient, error)
 	// EmissionsGridBounds returns the bounds of the grid used in GriddedEmissions.
 	EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error)
 	// GriddedConcentrations returns the concentrations resulting from the
 	// corresponding GriddedEmissions.
 	GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error)
+	// StreamGriddedConcentrations is the streaming version of
+	// GriddedConcentrations, in the same way as StreamGriddedEmissions.
+	StreamGriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedConcentrationsClient, error)
 	// MapScale returns the minimum and maximum values of the result
 	// of the given request.
 	MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error)
 	// GriddedPopulation returns the population counts---gridded to the
 	// concentration grid---associated with the given request.
 	GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error)
+	// StreamGriddedPopulation is the streaming version of
+	// GriddedPopulation, in the same way as StreamGriddedEmissions.
+	StreamGriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedPopulationClient, error)
 	// ImpactSummary returns a summary of the impacts from the given request.
 	ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error)
+	// Damages returns the monetized health damages per tonne of
+	// emissions from the given request.
+	Damages(ctx context.Context, in *DamagesRequest, opts ...grpc.CallOption) (*DamagesResponse, error)
+	// JobProgress reports the state of the concentration model job
+	// associated with the given request each time it changes, until
+	// the results are cached or the job fails.
+	JobProgress(ctx context.Context, in *JobProgressRequest, opts ...grpc.CallOption) (CityAQ_JobProgressClient, error)
+	// SubmitConcentrationJob starts calculating the concentrations
+	// associated with the given request and returns without waiting
+	// for the calculation to finish.
+	SubmitConcentrationJob(ctx context.Context, in *SubmitConcentrationJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// GetJob returns the current state of the specified job.
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// CancelJob stops the specified job.
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// ListJobs returns all known concentration jobs.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// ListCachedResults returns information about the cached concentration
+	// results that match the request. It requires administrator access.
+	ListCachedResults(ctx context.Context, in *ListCachedResultsRequest, opts ...grpc.CallOption) (*ListCachedResultsResponse, error)
+	// CachedResult returns information about the cached concentration
+	// result with the given key. It requires administrator access.
+	CachedResult(ctx context.Context, in *CachedResultRequest, opts ...grpc.CallOption) (*CachedResultInfo, error)
+	// InvalidateCachedResults deletes the cached concentration results
+	// that match the request. It requires administrator access.
+	InvalidateCachedResults(ctx context.Context, in *InvalidateCachedResultsRequest, opts ...grpc.CallOption) (*InvalidateCachedResultsResponse, error)
+}
+
+type cityAQClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCityAQClient(cc grpc.ClientConnInterface) CityAQClient {
+	return &cityAQClient{cc}
+}
+
+func (c *cityAQClient) Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error) {
+	out := new(CitiesResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Cities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) Options(ctx context.Context, in *OptionsRequest, opts ...grpc.CallOption) (*OptionsResponse, error) {
+	out := new(OptionsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Options", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error) {
+	out := new(CityGeometryResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CityGeometry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error) {
+	out := new(GriddedEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedEmissionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[0], "/cityaqrpc.CityAQ/StreamGriddedEmissions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedEmissionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_StreamGriddedEmissionsClient interface {
+	Recv() (*GriddedEmissionsResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQStreamGriddedEmissionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQStreamGriddedEmissionsClient) Recv() (*GriddedEmissionsResponse, error) {
+	m := new(GriddedEmissionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error) {
+	out := new(EmissionsGridBoundsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/EmissionsGridBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error) {
+	out := new(GriddedConcentrationsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedConcentrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedConcentrationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[1], "/cityaqrpc.CityAQ/StreamGriddedConcentrations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedConcentrationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_StreamGriddedConcentrationsClient interface {
+	Recv() (*GriddedConcentrationsResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQStreamGriddedConcentrationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQStreamGriddedConcentrationsClient) Recv() (*GriddedConcentrationsResponse, error) {
+	m := new(GriddedConcentrationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error) {
+	out := new(MapScaleResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/MapScale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error) {
+	out := new(GriddedPopulationResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedPopulation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedPopulationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[2], "/cityaqrpc.CityAQ/StreamGriddedPopulation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedPopulationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type cityAQClient struct {
-	cc grpc.ClientConnInterface
+type CityAQ_StreamGriddedPopulationClient interface {
+	Recv() (*GriddedPopulationResponse, error)
+	grpc.ClientStream
 }
 
-func NewCityAQClient(cc grpc.ClientConnInterface) CityAQClient {
-	return &cityAQClient{cc}
+type cityAQStreamGriddedPopulationClient struct {
+	grpc.ClientStream
 }
 
-func (c *cityAQClient) Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error) {
-	out := new(CitiesResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Cities", in, out, opts...)
+func (x *cityAQStreamGriddedPopulationClient) Recv() (*GriddedPopulationResponse, error) {
+	m := new(GriddedPopulationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error) {
+	out := new(ImpactSummaryResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ImpactSummary", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error) {
-	out := new(CityGeometryResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CityGeometry", in, out, opts...)
+func (c *cityAQClient) Damages(ctx context.Context, in *DamagesRequest, opts ...grpc.CallOption) (*DamagesResponse, error) {
+	out := new(DamagesResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Damages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error) {
-	out := new(GriddedEmissionsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedEmissions", in, out, opts...)
+func (c *cityAQClient) JobProgress(ctx context.Context, in *JobProgressRequest, opts ...grpc.CallOption) (CityAQ_JobProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[3], "/cityaqrpc.CityAQ/JobProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQJobProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_JobProgressClient interface {
+	Recv() (*JobProgressResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQJobProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQJobProgressClient) Recv() (*JobProgressResponse, error) {
+	m := new(JobProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) SubmitConcentrationJob(ctx context.Context, in *SubmitConcentrationJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/SubmitConcentrationJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error) {
-	out := new(EmissionsGridBoundsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/EmissionsGridBounds", in, out, opts...)
+func (c *cityAQClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GetJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error) {
-	out := new(GriddedConcentrationsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedConcentrations", in, out, opts...)
+func (c *cityAQClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CancelJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error) {
-	out := new(MapScaleResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/MapScale", in, out, opts...)
+func (c *cityAQClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ListJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error) {
-	out := new(GriddedPopulationResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedPopulation", in, out, opts...)
+func (c *cityAQClient) ListCachedResults(ctx context.Context, in *ListCachedResultsRequest, opts ...grpc.CallOption) (*ListCachedResultsResponse, error) {
+	out := new(ListCachedResultsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ListCachedResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error) {
-	out := new(ImpactSummaryResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ImpactSummary", in, out, opts...)
+func (c *cityAQClient) CachedResult(ctx context.Context, in *CachedResultRequest, opts ...grpc.CallOption) (*CachedResultInfo, error) {
+	out := new(CachedResultInfo)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CachedResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) InvalidateCachedResults(ctx context.Context, in *InvalidateCachedResultsRequest, opts ...grpc.CallOption) (*InvalidateCachedResultsResponse, error) {
+	out := new(InvalidateCachedResultsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/InvalidateCachedResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1853,24 +5351,65 @@ func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryReque
 type CityAQServer interface {
 	// Cities returns the available cities.
 	Cities(context.Context, *CitiesRequest) (*CitiesResponse, error)
+	// Options returns the source types, pollutants and impact types
+	// that can be requested, as configured on the server.
+	Options(context.Context, *OptionsRequest) (*OptionsResponse, error)
 	// CityGeometry returns the boundary of the specified city.
 	CityGeometry(context.Context, *CityGeometryRequest) (*CityGeometryResponse, error)
 	// GriddedEmissions returns the distribution within the city of
-	// 1 kilotonne of emissions.
+	// the requested amount of emissions (by default 1 kilotonne).
 	GriddedEmissions(context.Context, *GriddedEmissionsRequest) (*GriddedEmissionsResponse, error)
+	// StreamGriddedEmissions returns the same information as
+	// GriddedEmissions, split into messages that each hold a subset of
+	// the grid cells, in order. When compact encoding is requested, a
+	// regular grid is only described by the first message, and otherwise
+	// each message holds the CellBounds of its own cells.
+	StreamGriddedEmissions(*GriddedEmissionsRequest, CityAQ_StreamGriddedEmissionsServer) error
 	// EmissionsGridBounds returns the bounds of the grid used in GriddedEmissions.
 	EmissionsGridBounds(context.Context, *EmissionsGridBoundsRequest) (*EmissionsGridBoundsResponse, error)
 	// GriddedConcentrations returns the concentrations resulting from the
 	// corresponding GriddedEmissions.
 	GriddedConcentrations(context.Context, *GriddedConcentrationsRequest) (*GriddedConcentrationsResponse, error)
+	// StreamGriddedConcentrations is the streaming version of
+	// GriddedConcentrations, in the same way as StreamGriddedEmissions.
+	StreamGriddedConcentrations(*GriddedConcentrationsRequest, CityAQ_StreamGriddedConcentrationsServer) error
 	// MapScale returns the minimum and maximum values of the result
 	// of the given request.
 	MapScale(context.Context, *MapScaleRequest) (*MapScaleResponse, error)
 	// GriddedPopulation returns the population counts---gridded to the
 	// concentration grid---associated with the given request.
 	GriddedPopulation(context.Context, *GriddedPopulationRequest) (*GriddedPopulationResponse, error)
+	// StreamGriddedPopulation is the streaming version of
+	// GriddedPopulation, in the same way as StreamGriddedEmissions.
+	StreamGriddedPopulation(*GriddedPopulationRequest, CityAQ_StreamGriddedPopulationServer) error
 	// ImpactSummary returns a summary of the impacts from the given request.
 	ImpactSummary(context.Context, *ImpactSummaryRequest) (*ImpactSummaryResponse, error)
+	// Damages returns the monetized health damages per tonne of
+	// emissions from the given request.
+	Damages(context.Context, *DamagesRequest) (*DamagesResponse, error)
+	// JobProgress reports the state of the concentration model job
+	// associated with the given request each time it changes, until
+	// the results are cached or the job fails.
+	JobProgress(*JobProgressRequest, CityAQ_JobProgressServer) error
+	// SubmitConcentrationJob starts calculating the concentrations
+	// associated with the given request and returns without waiting
+	// for the calculation to finish.
+	SubmitConcentrationJob(context.Context, *SubmitConcentrationJobRequest) (*Job, error)
+	// GetJob returns the current state of the specified job.
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+	// CancelJob stops the specified job.
+	CancelJob(context.Context, *CancelJobRequest) (*Job, error)
+	// ListJobs returns all known concentration jobs.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// ListCachedResults returns information about the cached concentration
+	// results that match the request. It requires administrator access.
+	ListCachedResults(context.Context, *ListCachedResultsRequest) (*ListCachedResultsResponse, error)
+	// CachedResult returns information about the cached concentration
+	// result with the given key. It requires administrator access.
+	CachedResult(context.Context, *CachedResultRequest) (*CachedResultInfo, error)
+	// InvalidateCachedResults deletes the cached concentration results
+	// that match the request. It requires administrator access.
+	InvalidateCachedResults(context.Context, *InvalidateCachedResultsRequest) (*InvalidateCachedResultsResponse, error)
 }
 
 // UnimplementedCityAQServer can be embedded to have forward compatible implementations.
@@ -1880,27 +5419,66 @@ type UnimplementedCityAQServer struct {
 func (*UnimplementedCityAQServer) Cities(context.Context, *CitiesRequest) (*CitiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
 }
+func (*UnimplementedCityAQServer) Options(context.Context, *OptionsRequest) (*OptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Options not implemented")
+}
 func (*UnimplementedCityAQServer) CityGeometry(context.Context, *CityGeometryRequest) (*CityGeometryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CityGeometry not implemented")
 }
 func (*UnimplementedCityAQServer) GriddedEmissions(context.Context, *GriddedEmissionsRequest) (*GriddedEmissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GriddedEmissions not implemented")
 }
+func (*UnimplementedCityAQServer) StreamGriddedEmissions(*GriddedEmissionsRequest, CityAQ_StreamGriddedEmissionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGriddedEmissions not implemented")
+}
 func (*UnimplementedCityAQServer) EmissionsGridBounds(context.Context, *EmissionsGridBoundsRequest) (*EmissionsGridBoundsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmissionsGridBounds not implemented")
 }
 func (*UnimplementedCityAQServer) GriddedConcentrations(context.Context, *GriddedConcentrationsRequest) (*GriddedConcentrationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GriddedConcentrations not implemented")
 }
+func (*UnimplementedCityAQServer) StreamGriddedConcentrations(*GriddedConcentrationsRequest, CityAQ_StreamGriddedConcentrationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGriddedConcentrations not implemented")
+}
 func (*UnimplementedCityAQServer) MapScale(context.Context, *MapScaleRequest) (*MapScaleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MapScale not implemented")
 }
 func (*UnimplementedCityAQServer) GriddedPopulation(context.Context, *GriddedPopulationRequest) (*GriddedPopulationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GriddedPopulation not implemented")
 }
+func (*UnimplementedCityAQServer) StreamGriddedPopulation(*GriddedPopulationRequest, CityAQ_StreamGriddedPopulationServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGriddedPopulation not implemented")
+}
 func (*UnimplementedCityAQServer) ImpactSummary(context.Context, *ImpactSummaryRequest) (*ImpactSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImpactSummary not implemented")
 }
+func (*UnimplementedCityAQServer) Damages(context.Context, *DamagesRequest) (*DamagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Damages not implemented")
+}
+func (*UnimplementedCityAQServer) JobProgress(*JobProgressRequest, CityAQ_JobProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method JobProgress not implemented")
+}
+func (*UnimplementedCityAQServer) SubmitConcentrationJob(context.Context, *SubmitConcentrationJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitConcentrationJob not implemented")
+}
+func (*UnimplementedCityAQServer) GetJob(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (*UnimplementedCityAQServer) CancelJob(context.Context, *CancelJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (*UnimplementedCityAQServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (*UnimplementedCityAQServer) ListCachedResults(context.Context, *ListCachedResultsRequest) (*ListCachedResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCachedResults not implemented")
+}
+func (*UnimplementedCityAQServer) CachedResult(context.Context, *CachedResultRequest) (*CachedResultInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CachedResult not implemented")
+}
+func (*UnimplementedCityAQServer) InvalidateCachedResults(context.Context, *InvalidateCachedResultsRequest) (*InvalidateCachedResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCachedResults not implemented")
+}
 
 func RegisterCityAQServer(s *grpc.Server, srv CityAQServer) {
 	s.RegisterService(&_CityAQ_serviceDesc, srv)
@@ -1924,6 +5502,24 @@ func _CityAQ_Cities_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_Options_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).Options(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/Options",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).Options(ctx, req.(*OptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CityAQ_CityGeometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CityGeometryRequest)
 	if err := dec(in); err != nil {
@@ -1960,6 +5556,27 @@ func _CityAQ_GriddedEmissions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedEmissions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedEmissionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedEmissions(m, &cityAQStreamGriddedEmissionsServer{stream})
+}
+
+type CityAQ_StreamGriddedEmissionsServer interface {
+	Send(*GriddedEmissionsResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedEmissionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedEmissionsServer) Send(m *GriddedEmissionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_EmissionsGridBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmissionsGridBoundsRequest)
 	if err := dec(in); err != nil {
@@ -1996,6 +5613,27 @@ func _CityAQ_GriddedConcentrations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedConcentrations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedConcentrationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedConcentrations(m, &cityAQStreamGriddedConcentrationsServer{stream})
+}
+
+type CityAQ_StreamGriddedConcentrationsServer interface {
+	Send(*GriddedConcentrationsResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedConcentrationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedConcentrationsServer) Send(m *GriddedConcentrationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_MapScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MapScaleRequest)
 	if err := dec(in); err != nil {
@@ -2032,6 +5670,27 @@ func _CityAQ_GriddedPopulation_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedPopulation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedPopulationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedPopulation(m, &cityAQStreamGriddedPopulationServer{stream})
+}
+
+type CityAQ_StreamGriddedPopulationServer interface {
+	Send(*GriddedPopulationResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedPopulationServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedPopulationServer) Send(m *GriddedPopulationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_ImpactSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImpactSummaryRequest)
 	if err := dec(in); err != nil {
@@ -2050,6 +5709,171 @@ func _CityAQ_ImpactSummary_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_Damages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DamagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).Damages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/Damages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).Damages(ctx, req.(*DamagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_JobProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).JobProgress(m, &cityAQJobProgressServer{stream})
+}
+
+type CityAQ_JobProgressServer interface {
+	Send(*JobProgressResponse) error
+	grpc.ServerStream
+}
+
+type cityAQJobProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQJobProgressServer) Send(m *JobProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CityAQ_SubmitConcentrationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitConcentrationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).SubmitConcentrationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/SubmitConcentrationJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).SubmitConcentrationJob(ctx, req.(*SubmitConcentrationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_ListCachedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCachedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).ListCachedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/ListCachedResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).ListCachedResults(ctx, req.(*ListCachedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_CachedResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CachedResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).CachedResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/CachedResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).CachedResult(ctx, req.(*CachedResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_InvalidateCachedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCachedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).InvalidateCachedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/InvalidateCachedResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).InvalidateCachedResults(ctx, req.(*InvalidateCachedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CityAQ_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cityaqrpc.CityAQ",
 	HandlerType: (*CityAQServer)(nil),
@@ -2058,6 +5882,10 @@ var _CityAQ_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Cities",
 			Handler:    _CityAQ_Cities_Handler,
 		},
+		{
+			MethodName: "Options",
+			Handler:    _CityAQ_Options_Handler,
+		},
 		{
 			MethodName: "CityGeometry",
 			Handler:    _CityAQ_CityGeometry_Handler,
@@ -2086,7 +5914,60 @@ var _CityAQ_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImpactSummary",
 			Handler:    _CityAQ_ImpactSummary_Handler,
 		},
+		{
+			MethodName: "Damages",
+			Handler:    _CityAQ_Damages_Handler,
+		},
+		{
+			MethodName: "SubmitConcentrationJob",
+			Handler:    _CityAQ_SubmitConcentrationJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _CityAQ_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _CityAQ_CancelJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _CityAQ_ListJobs_Handler,
+		},
+		{
+			MethodName: "ListCachedResults",
+			Handler:    _CityAQ_ListCachedResults_Handler,
+		},
+		{
+			MethodName: "CachedResult",
+			Handler:    _CityAQ_CachedResult_Handler,
+		},
+		{
+			MethodName: "InvalidateCachedResults",
+			Handler:    _CityAQ_InvalidateCachedResults_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGriddedEmissions",
+			Handler:       _CityAQ_StreamGriddedEmissions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGriddedConcentrations",
+			Handler:       _CityAQ_StreamGriddedConcentrations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGriddedPopulation",
+			Handler:       _CityAQ_StreamGriddedPopulation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JobProgress",
+			Handler:       _CityAQ_JobProgress_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cityaq.proto",
 }
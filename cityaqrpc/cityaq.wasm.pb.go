@@ -25,6 +25,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// JobState specifies the progress of a concentration model job.
+type JobState int32
+
+const (
+	// UNKNOWN_JOBSTATE means that the job has not been requested.
+	JobState_UNKNOWN_JOBSTATE JobState = 0
+	// Queued means that the job is waiting to be started.
+	JobState_Queued JobState = 1
+	// EmissionsGridding means that the emissions are being
+	// allocated to the model grid.
+	JobState_EmissionsGridding JobState = 2
+	// Submitted means that the job has been submitted to the model
+	// but has not yet started.
+	JobState_Submitted JobState = 3
+	// Running means that the model is running.
+	JobState_Running JobState = 4
+	// Downloading means that the model results are being retrieved.
+	JobState_Downloading JobState = 5
+	// Cached means that the job has finished and its results are
+	// available.
+	JobState_Cached JobState = 6
+	// Failed means that the job did not complete successfully.
+	JobState_Failed JobState = 7
+	// Canceled means that the job was stopped by CancelJob.
+	JobState_Canceled JobState = 8
+)
+
+var JobState_name = map[int32]string{
+	0: "UNKNOWN_JOBSTATE",
+	1: "Queued",
+	2: "EmissionsGridding",
+	3: "Submitted",
+	4: "Running",
+	5: "Downloading",
+	6: "Cached",
+	7: "Failed",
+	8: "Canceled",
+}
+var JobState_value = map[string]int32{
+	"UNKNOWN_JOBSTATE":  0,
+	"Queued":            1,
+	"EmissionsGridding": 2,
+	"Submitted":         3,
+	"Running":           4,
+	"Downloading":       5,
+	"Cached":            6,
+	"Failed":            7,
+	"Canceled":          8,
+}
+
+func (x JobState) String() string {
+	return proto.EnumName(JobState_name, int32(x))
+}
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{0}
+}
+
 type Emission int32
 
 const (
@@ -57,36 +114,106 @@ func (x Emission) String() string {
 	return proto.EnumName(Emission_name, int32(x))
 }
 func (Emission) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{0}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{1}
+}
+
+// GridEncoding specifies how the cells of a grid are represented.
+type GridEncoding int32
+
+const (
+	// PolygonEncoding represents each cell as a polygon. It is the default.
+	GridEncoding_PolygonEncoding GridEncoding = 0
+	// CompactEncoding represents the cells using a CompactGrid message
+	// and all of the values of each cell as single-precision numbers.
+	GridEncoding_CompactEncoding GridEncoding = 1
+)
+
+var GridEncoding_name = map[int32]string{
+	0: "PolygonEncoding",
+	1: "CompactEncoding",
+}
+var GridEncoding_value = map[string]int32{
+	"PolygonEncoding": 0,
+	"CompactEncoding": 1,
+}
+
+func (x GridEncoding) String() string {
+	return proto.EnumName(GridEncoding_name, int32(x))
+}
+func (GridEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{2}
 }
 
 type ImpactType int32
 
 const (
-	ImpactType_UNKNOWN_IMPACTTYPE ImpactType = 0
-	ImpactType_Emissions          ImpactType = 1
-	ImpactType_Concentrations     ImpactType = 2
+	ImpactType_UNKNOWN_IMPACTTYPE  ImpactType = 0
+	ImpactType_Emissions           ImpactType = 1
+	ImpactType_Concentrations      ImpactType = 2
+	ImpactType_TotalConcentrations ImpactType = 3
 )
 
 var ImpactType_name = map[int32]string{
 	0: "UNKNOWN_IMPACTTYPE",
 	1: "Emissions",
 	2: "Concentrations",
+	3: "TotalConcentrations",
 }
 var ImpactType_value = map[string]int32{
-	"UNKNOWN_IMPACTTYPE": 0,
-	"Emissions":          1,
-	"Concentrations":     2,
+	"UNKNOWN_IMPACTTYPE":  0,
+	"Emissions":           1,
+	"Concentrations":      2,
+	"TotalConcentrations": 3,
 }
 
 func (x ImpactType) String() string {
 	return proto.EnumName(ImpactType_name, int32(x))
 }
 func (ImpactType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{1}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{3}
+}
+
+// ConcentrationResponse specifies a function relating changes in
+// PM2.5 concentrations to changes in mortality.
+type ConcentrationResponse int32
+
+const (
+	ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE ConcentrationResponse = 0
+	// Krewski2009 is a log-linear function with a 6% increase in
+	// all-cause mortality per 10 μg m-3 of PM2.5, from Krewski et
+	// al. (2009).
+	ConcentrationResponse_Krewski2009 ConcentrationResponse = 1
+	// GEMM is the Global Exposure Mortality Model for non-communicable
+	// diseases and lower respiratory infections, from Burnett et
+	// al. (2018).
+	ConcentrationResponse_GEMM ConcentrationResponse = 2
+)
+
+var ConcentrationResponse_name = map[int32]string{
+	0: "UNKNOWN_CONCENTRATIONRESPONSE",
+	1: "Krewski2009",
+	2: "GEMM",
+}
+var ConcentrationResponse_value = map[string]int32{
+	"UNKNOWN_CONCENTRATIONRESPONSE": 0,
+	"Krewski2009":                   1,
+	"GEMM":                          2,
+}
+
+func (x ConcentrationResponse) String() string {
+	return proto.EnumName(ConcentrationResponse_name, int32(x))
+}
+func (ConcentrationResponse) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{4}
 }
 
 type CitiesRequest struct {
+	// Language is the code of the language that the names of the cities
+	// should be in, such as "es" or "ur", as used in the name:xx
+	// properties of OpenStreetMap. Cities without a name in the language
+	// are given their English name. If it is empty, the names that are
+	// used in requests are returned.
+	Language             string   `protobuf:"bytes,1,opt,name=Language,proto3" json:"Language,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -96,7 +223,7 @@ func (m *CitiesRequest) Reset()         { *m = CitiesRequest{} }
 func (m *CitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*CitiesRequest) ProtoMessage()    {}
 func (*CitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{0}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{0}
 }
 func (m *CitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CitiesRequest.Unmarshal(m, b)
@@ -116,9 +243,19 @@ func (m *CitiesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CitiesRequest proto.InternalMessageInfo
 
+func (m *CitiesRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
 type CitiesResponse struct {
-	// The names of the cities
-	Names                []string `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	// The names of the cities, in the requested language. Localized
+	// names can't be used in requests: use City.Name or City.ID instead.
+	Names []string `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	// Information about each city, in the same order as Names.
+	Cities               []*City  `protobuf:"bytes,2,rep,name=Cities,proto3" json:"Cities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,7 +265,7 @@ func (m *CitiesResponse) Reset()         { *m = CitiesResponse{} }
 func (m *CitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*CitiesResponse) ProtoMessage()    {}
 func (*CitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{1}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{1}
 }
 func (m *CitiesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CitiesResponse.Unmarshal(m, b)
@@ -155,642 +292,2598 @@ func (m *CitiesResponse) GetNames() []string {
 	return nil
 }
 
-type CityGeometryRequest struct {
-	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CitiesResponse) GetCities() []*City {
+	if m != nil {
+		return m.Cities
+	}
+	return nil
 }
 
-func (m *CityGeometryRequest) Reset()         { *m = CityGeometryRequest{} }
-func (m *CityGeometryRequest) String() string { return proto.CompactTextString(m) }
-func (*CityGeometryRequest) ProtoMessage()    {}
-func (*CityGeometryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{2}
+// City holds information about a city.
+type City struct {
+	// ID is a stable identifier of the city, which is the path of the
+	// file containing its boundary, relative to the city directory and
+	// without the extension. It can be used in place of the name
+	// of the city in requests.
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Name is the name of the city that is used in requests.
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// DisplayNames holds the names of the city in different
+	// languages, from the name:xx GeoJSON properties, keyed by
+	// language code.
+	DisplayNames map[string]string `protobuf:"bytes,3,rep,name=DisplayNames,proto3" json:"DisplayNames,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Country is the name of the country that the city is in, or empty
+	// if it isn't known.
+	Country  string       `protobuf:"bytes,4,opt,name=Country,proto3" json:"Country,omitempty"`
+	Centroid *Point       `protobuf:"bytes,5,opt,name=Centroid,proto3" json:"Centroid,omitempty"`
+	Bounds   *BoundingBox `protobuf:"bytes,6,opt,name=Bounds,proto3" json:"Bounds,omitempty"`
+	// Area is the area of the city in km².
+	Area float64 `protobuf:"fixed64,7,opt,name=Area,proto3" json:"Area,omitempty"`
+	// Properties holds the properties of the city in its GeoJSON file,
+	// with each value in JSON format.
+	Properties           map[string]string `protobuf:"bytes,8,rep,name=Properties,proto3" json:"Properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *City) Reset()         { *m = City{} }
+func (m *City) String() string { return proto.CompactTextString(m) }
+func (*City) ProtoMessage()    {}
+func (*City) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{2}
+}
+func (m *City) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_City.Unmarshal(m, b)
+}
+func (m *City) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_City.Marshal(b, m, deterministic)
+}
+func (dst *City) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_City.Merge(dst, src)
+}
+func (m *City) XXX_Size() int {
+	return xxx_messageInfo_City.Size(m)
+}
+func (m *City) XXX_DiscardUnknown() {
+	xxx_messageInfo_City.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_City proto.InternalMessageInfo
+
+func (m *City) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
 }
-func (m *CityGeometryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityGeometryRequest.Unmarshal(m, b)
+
+func (m *City) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (m *CityGeometryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityGeometryRequest.Marshal(b, m, deterministic)
+
+func (m *City) GetDisplayNames() map[string]string {
+	if m != nil {
+		return m.DisplayNames
+	}
+	return nil
 }
-func (dst *CityGeometryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityGeometryRequest.Merge(dst, src)
+
+func (m *City) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
 }
-func (m *CityGeometryRequest) XXX_Size() int {
-	return xxx_messageInfo_CityGeometryRequest.Size(m)
+
+func (m *City) GetCentroid() *Point {
+	if m != nil {
+		return m.Centroid
+	}
+	return nil
 }
-func (m *CityGeometryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityGeometryRequest.DiscardUnknown(m)
+
+func (m *City) GetBounds() *BoundingBox {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
 }
 
-var xxx_messageInfo_CityGeometryRequest proto.InternalMessageInfo
+func (m *City) GetArea() float64 {
+	if m != nil {
+		return m.Area
+	}
+	return 0
+}
 
-func (m *CityGeometryRequest) GetCityName() string {
+func (m *City) GetProperties() map[string]string {
 	if m != nil {
-		return m.CityName
+		return m.Properties
 	}
-	return ""
+	return nil
 }
 
-type CityGeometryResponse struct {
-	Polygons             []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type BoundingBox struct {
+	Min                  *Point   `protobuf:"bytes,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max                  *Point   `protobuf:"bytes,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CityGeometryResponse) Reset()         { *m = CityGeometryResponse{} }
-func (m *CityGeometryResponse) String() string { return proto.CompactTextString(m) }
-func (*CityGeometryResponse) ProtoMessage()    {}
-func (*CityGeometryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{3}
+func (m *BoundingBox) Reset()         { *m = BoundingBox{} }
+func (m *BoundingBox) String() string { return proto.CompactTextString(m) }
+func (*BoundingBox) ProtoMessage()    {}
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{3}
 }
-func (m *CityGeometryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CityGeometryResponse.Unmarshal(m, b)
+func (m *BoundingBox) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundingBox.Unmarshal(m, b)
 }
-func (m *CityGeometryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CityGeometryResponse.Marshal(b, m, deterministic)
+func (m *BoundingBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundingBox.Marshal(b, m, deterministic)
 }
-func (dst *CityGeometryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CityGeometryResponse.Merge(dst, src)
+func (dst *BoundingBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundingBox.Merge(dst, src)
 }
-func (m *CityGeometryResponse) XXX_Size() int {
-	return xxx_messageInfo_CityGeometryResponse.Size(m)
+func (m *BoundingBox) XXX_Size() int {
+	return xxx_messageInfo_BoundingBox.Size(m)
 }
-func (m *CityGeometryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CityGeometryResponse.DiscardUnknown(m)
+func (m *BoundingBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundingBox.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CityGeometryResponse proto.InternalMessageInfo
+var xxx_messageInfo_BoundingBox proto.InternalMessageInfo
 
-func (m *CityGeometryResponse) GetPolygons() []*Polygon {
+func (m *BoundingBox) GetMin() *Point {
 	if m != nil {
-		return m.Polygons
+		return m.Min
 	}
 	return nil
 }
 
-type Polygon struct {
-	Paths                []*Path  `protobuf:"bytes,1,rep,name=Paths,proto3" json:"Paths,omitempty"`
+func (m *BoundingBox) GetMax() *Point {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+type OptionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Polygon) Reset()         { *m = Polygon{} }
-func (m *Polygon) String() string { return proto.CompactTextString(m) }
-func (*Polygon) ProtoMessage()    {}
-func (*Polygon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{4}
+func (m *OptionsRequest) Reset()         { *m = OptionsRequest{} }
+func (m *OptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*OptionsRequest) ProtoMessage()    {}
+func (*OptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{4}
 }
-func (m *Polygon) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Polygon.Unmarshal(m, b)
+func (m *OptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OptionsRequest.Unmarshal(m, b)
 }
-func (m *Polygon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Polygon.Marshal(b, m, deterministic)
+func (m *OptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OptionsRequest.Marshal(b, m, deterministic)
 }
-func (dst *Polygon) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Polygon.Merge(dst, src)
+func (dst *OptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionsRequest.Merge(dst, src)
 }
-func (m *Polygon) XXX_Size() int {
-	return xxx_messageInfo_Polygon.Size(m)
+func (m *OptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_OptionsRequest.Size(m)
 }
-func (m *Polygon) XXX_DiscardUnknown() {
-	xxx_messageInfo_Polygon.DiscardUnknown(m)
+func (m *OptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Polygon proto.InternalMessageInfo
+var xxx_messageInfo_OptionsRequest proto.InternalMessageInfo
 
-func (m *Polygon) GetPaths() []*Path {
+type OptionsResponse struct {
+	SourceTypes          []*SourceTypeInfo `protobuf:"bytes,1,rep,name=SourceTypes,proto3" json:"SourceTypes,omitempty"`
+	Emissions            []*EmissionInfo   `protobuf:"bytes,2,rep,name=Emissions,proto3" json:"Emissions,omitempty"`
+	ImpactTypes          []*ImpactTypeInfo `protobuf:"bytes,3,rep,name=ImpactTypes,proto3" json:"ImpactTypes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OptionsResponse) Reset()         { *m = OptionsResponse{} }
+func (m *OptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*OptionsResponse) ProtoMessage()    {}
+func (*OptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{5}
+}
+func (m *OptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OptionsResponse.Unmarshal(m, b)
+}
+func (m *OptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OptionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *OptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionsResponse.Merge(dst, src)
+}
+func (m *OptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_OptionsResponse.Size(m)
+}
+func (m *OptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionsResponse proto.InternalMessageInfo
+
+func (m *OptionsResponse) GetSourceTypes() []*SourceTypeInfo {
 	if m != nil {
-		return m.Paths
+		return m.SourceTypes
 	}
 	return nil
 }
 
-type Path struct {
-	Points               []*Point `protobuf:"bytes,1,rep,name=Points,proto3" json:"Points,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *OptionsResponse) GetEmissions() []*EmissionInfo {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
 }
 
-func (m *Path) Reset()         { *m = Path{} }
-func (m *Path) String() string { return proto.CompactTextString(m) }
-func (*Path) ProtoMessage()    {}
-func (*Path) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{5}
+func (m *OptionsResponse) GetImpactTypes() []*ImpactTypeInfo {
+	if m != nil {
+		return m.ImpactTypes
+	}
+	return nil
 }
-func (m *Path) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Path.Unmarshal(m, b)
+
+// SourceTypeInfo describes a source type, which specifies the spatial
+// surrogate used to allocate emissions.
+type SourceTypeInfo struct {
+	// Name is the value to use as the SourceType field of requests.
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// EGUGrid specifies whether emissions are allocated to the country
+	// that the city is in (or the part of it near the city), rather
+	// than to the city itself.
+	EGUGrid bool `protobuf:"varint,4,opt,name=EGUGrid,proto3" json:"EGUGrid,omitempty"`
+	// OSMTags are the OpenStreetMap tags of the features used to
+	// allocate emissions, if the surrogate is based on OpenStreetMap.
+	OSMTags              []*OSMTag `protobuf:"bytes,5,rep,name=OSMTags,proto3" json:"OSMTags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Path.Marshal(b, m, deterministic)
+
+func (m *SourceTypeInfo) Reset()         { *m = SourceTypeInfo{} }
+func (m *SourceTypeInfo) String() string { return proto.CompactTextString(m) }
+func (*SourceTypeInfo) ProtoMessage()    {}
+func (*SourceTypeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{6}
 }
-func (dst *Path) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Path.Merge(dst, src)
+func (m *SourceTypeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceTypeInfo.Unmarshal(m, b)
 }
-func (m *Path) XXX_Size() int {
-	return xxx_messageInfo_Path.Size(m)
+func (m *SourceTypeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceTypeInfo.Marshal(b, m, deterministic)
 }
-func (m *Path) XXX_DiscardUnknown() {
-	xxx_messageInfo_Path.DiscardUnknown(m)
+func (dst *SourceTypeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceTypeInfo.Merge(dst, src)
+}
+func (m *SourceTypeInfo) XXX_Size() int {
+	return xxx_messageInfo_SourceTypeInfo.Size(m)
+}
+func (m *SourceTypeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceTypeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Path proto.InternalMessageInfo
+var xxx_messageInfo_SourceTypeInfo proto.InternalMessageInfo
 
-func (m *Path) GetPoints() []*Point {
+func (m *SourceTypeInfo) GetName() string {
 	if m != nil {
-		return m.Points
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SourceTypeInfo) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *SourceTypeInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SourceTypeInfo) GetEGUGrid() bool {
+	if m != nil {
+		return m.EGUGrid
+	}
+	return false
+}
+
+func (m *SourceTypeInfo) GetOSMTags() []*OSMTag {
+	if m != nil {
+		return m.OSMTags
 	}
 	return nil
 }
 
-type Point struct {
-	X                    float64  `protobuf:"fixed64,1,opt,name=X,proto3" json:"X,omitempty"`
-	Y                    float64  `protobuf:"fixed64,2,opt,name=Y,proto3" json:"Y,omitempty"`
+// OSMTag is an OpenStreetMap tag key and the values it can have.
+// If there are no values, any value matches.
+type OSMTag struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values               []string `protobuf:"bytes,2,rep,name=Values,proto3" json:"Values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Point) Reset()         { *m = Point{} }
-func (m *Point) String() string { return proto.CompactTextString(m) }
-func (*Point) ProtoMessage()    {}
-func (*Point) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{6}
+func (m *OSMTag) Reset()         { *m = OSMTag{} }
+func (m *OSMTag) String() string { return proto.CompactTextString(m) }
+func (*OSMTag) ProtoMessage()    {}
+func (*OSMTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{7}
 }
-func (m *Point) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Point.Unmarshal(m, b)
+func (m *OSMTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OSMTag.Unmarshal(m, b)
 }
-func (m *Point) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Point.Marshal(b, m, deterministic)
+func (m *OSMTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OSMTag.Marshal(b, m, deterministic)
 }
-func (dst *Point) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Point.Merge(dst, src)
+func (dst *OSMTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSMTag.Merge(dst, src)
 }
-func (m *Point) XXX_Size() int {
-	return xxx_messageInfo_Point.Size(m)
+func (m *OSMTag) XXX_Size() int {
+	return xxx_messageInfo_OSMTag.Size(m)
 }
-func (m *Point) XXX_DiscardUnknown() {
-	xxx_messageInfo_Point.DiscardUnknown(m)
+func (m *OSMTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSMTag.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Point proto.InternalMessageInfo
+var xxx_messageInfo_OSMTag proto.InternalMessageInfo
 
-func (m *Point) GetX() float64 {
+func (m *OSMTag) GetKey() string {
 	if m != nil {
-		return m.X
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *Point) GetY() float64 {
+func (m *OSMTag) GetValues() []string {
 	if m != nil {
-		return m.Y
+		return m.Values
 	}
-	return 0
+	return nil
 }
 
-type GriddedEmissionsRequest struct {
-	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType           string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission             Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+// EmissionInfo describes a pollutant that can be emitted.
+type EmissionInfo struct {
+	Emission    Emission `protobuf:"varint,1,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	DisplayName string   `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// Units are the units of gridded emissions of the pollutant.
+	Units                string   `protobuf:"bytes,4,opt,name=Units,proto3" json:"Units,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GriddedEmissionsRequest) Reset()         { *m = GriddedEmissionsRequest{} }
-func (m *GriddedEmissionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GriddedEmissionsRequest) ProtoMessage()    {}
-func (*GriddedEmissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{7}
+func (m *EmissionInfo) Reset()         { *m = EmissionInfo{} }
+func (m *EmissionInfo) String() string { return proto.CompactTextString(m) }
+func (*EmissionInfo) ProtoMessage()    {}
+func (*EmissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{8}
 }
-func (m *GriddedEmissionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedEmissionsRequest.Unmarshal(m, b)
+func (m *EmissionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmissionInfo.Unmarshal(m, b)
 }
-func (m *GriddedEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedEmissionsRequest.Marshal(b, m, deterministic)
+func (m *EmissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmissionInfo.Marshal(b, m, deterministic)
 }
-func (dst *GriddedEmissionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedEmissionsRequest.Merge(dst, src)
+func (dst *EmissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionInfo.Merge(dst, src)
 }
-func (m *GriddedEmissionsRequest) XXX_Size() int {
-	return xxx_messageInfo_GriddedEmissionsRequest.Size(m)
+func (m *EmissionInfo) XXX_Size() int {
+	return xxx_messageInfo_EmissionInfo.Size(m)
 }
-func (m *GriddedEmissionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedEmissionsRequest.DiscardUnknown(m)
+func (m *EmissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedEmissionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_EmissionInfo proto.InternalMessageInfo
 
-func (m *GriddedEmissionsRequest) GetCityName() string {
+func (m *EmissionInfo) GetEmission() Emission {
 	if m != nil {
-		return m.CityName
+		return m.Emission
 	}
-	return ""
+	return Emission_UNKNOWN_EMISSION
 }
 
-func (m *GriddedEmissionsRequest) GetSourceType() string {
+func (m *EmissionInfo) GetDisplayName() string {
 	if m != nil {
-		return m.SourceType
+		return m.DisplayName
 	}
 	return ""
 }
 
-func (m *GriddedEmissionsRequest) GetEmission() Emission {
+func (m *EmissionInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *EmissionInfo) GetUnits() string {
+	if m != nil {
+		return m.Units
+	}
+	return ""
+}
+
+// ImpactTypeInfo describes a type of impact.
+type ImpactTypeInfo struct {
+	ImpactType  ImpactType `protobuf:"varint,1,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
+	DisplayName string     `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Description string     `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	// Units are the units of gridded values of the impact.
+	Units                string   `protobuf:"bytes,4,opt,name=Units,proto3" json:"Units,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImpactTypeInfo) Reset()         { *m = ImpactTypeInfo{} }
+func (m *ImpactTypeInfo) String() string { return proto.CompactTextString(m) }
+func (*ImpactTypeInfo) ProtoMessage()    {}
+func (*ImpactTypeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{9}
+}
+func (m *ImpactTypeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpactTypeInfo.Unmarshal(m, b)
+}
+func (m *ImpactTypeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpactTypeInfo.Marshal(b, m, deterministic)
+}
+func (dst *ImpactTypeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpactTypeInfo.Merge(dst, src)
+}
+func (m *ImpactTypeInfo) XXX_Size() int {
+	return xxx_messageInfo_ImpactTypeInfo.Size(m)
+}
+func (m *ImpactTypeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpactTypeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpactTypeInfo proto.InternalMessageInfo
+
+func (m *ImpactTypeInfo) GetImpactType() ImpactType {
+	if m != nil {
+		return m.ImpactType
+	}
+	return ImpactType_UNKNOWN_IMPACTTYPE
+}
+
+func (m *ImpactTypeInfo) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ImpactTypeInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ImpactTypeInfo) GetUnits() string {
+	if m != nil {
+		return m.Units
+	}
+	return ""
+}
+
+type CityGeometryRequest struct {
+	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityGeometryRequest) Reset()         { *m = CityGeometryRequest{} }
+func (m *CityGeometryRequest) String() string { return proto.CompactTextString(m) }
+func (*CityGeometryRequest) ProtoMessage()    {}
+func (*CityGeometryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{10}
+}
+func (m *CityGeometryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityGeometryRequest.Unmarshal(m, b)
+}
+func (m *CityGeometryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityGeometryRequest.Marshal(b, m, deterministic)
+}
+func (dst *CityGeometryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityGeometryRequest.Merge(dst, src)
+}
+func (m *CityGeometryRequest) XXX_Size() int {
+	return xxx_messageInfo_CityGeometryRequest.Size(m)
+}
+func (m *CityGeometryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityGeometryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityGeometryRequest proto.InternalMessageInfo
+
+func (m *CityGeometryRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+type CityGeometryResponse struct {
+	Polygons             []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CityGeometryResponse) Reset()         { *m = CityGeometryResponse{} }
+func (m *CityGeometryResponse) String() string { return proto.CompactTextString(m) }
+func (*CityGeometryResponse) ProtoMessage()    {}
+func (*CityGeometryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{11}
+}
+func (m *CityGeometryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityGeometryResponse.Unmarshal(m, b)
+}
+func (m *CityGeometryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityGeometryResponse.Marshal(b, m, deterministic)
+}
+func (dst *CityGeometryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityGeometryResponse.Merge(dst, src)
+}
+func (m *CityGeometryResponse) XXX_Size() int {
+	return xxx_messageInfo_CityGeometryResponse.Size(m)
+}
+func (m *CityGeometryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityGeometryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityGeometryResponse proto.InternalMessageInfo
+
+func (m *CityGeometryResponse) GetPolygons() []*Polygon {
+	if m != nil {
+		return m.Polygons
+	}
+	return nil
+}
+
+type Polygon struct {
+	Paths                []*Path  `protobuf:"bytes,1,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Polygon) Reset()         { *m = Polygon{} }
+func (m *Polygon) String() string { return proto.CompactTextString(m) }
+func (*Polygon) ProtoMessage()    {}
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{12}
+}
+func (m *Polygon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Polygon.Unmarshal(m, b)
+}
+func (m *Polygon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Polygon.Marshal(b, m, deterministic)
+}
+func (dst *Polygon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Polygon.Merge(dst, src)
+}
+func (m *Polygon) XXX_Size() int {
+	return xxx_messageInfo_Polygon.Size(m)
+}
+func (m *Polygon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Polygon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Polygon proto.InternalMessageInfo
+
+func (m *Polygon) GetPaths() []*Path {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+type Path struct {
+	Points               []*Point `protobuf:"bytes,1,rep,name=Points,proto3" json:"Points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Path) Reset()         { *m = Path{} }
+func (m *Path) String() string { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()    {}
+func (*Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{13}
+}
+func (m *Path) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Path.Unmarshal(m, b)
+}
+func (m *Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Path.Marshal(b, m, deterministic)
+}
+func (dst *Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Path.Merge(dst, src)
+}
+func (m *Path) XXX_Size() int {
+	return xxx_messageInfo_Path.Size(m)
+}
+func (m *Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Path proto.InternalMessageInfo
+
+func (m *Path) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type Point struct {
+	X                    float64  `protobuf:"fixed64,1,opt,name=X,proto3" json:"X,omitempty"`
+	Y                    float64  `protobuf:"fixed64,2,opt,name=Y,proto3" json:"Y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Point) Reset()         { *m = Point{} }
+func (m *Point) String() string { return proto.CompactTextString(m) }
+func (*Point) ProtoMessage()    {}
+func (*Point) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{14}
+}
+func (m *Point) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Point.Unmarshal(m, b)
+}
+func (m *Point) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Point.Marshal(b, m, deterministic)
+}
+func (dst *Point) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Point.Merge(dst, src)
+}
+func (m *Point) XXX_Size() int {
+	return xxx_messageInfo_Point.Size(m)
+}
+func (m *Point) XXX_DiscardUnknown() {
+	xxx_messageInfo_Point.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Point proto.InternalMessageInfo
+
+func (m *Point) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *Point) GetY() float64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+type GriddedEmissionsRequest struct {
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding             GridEncoding `protobuf:"varint,6,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GriddedEmissionsRequest) Reset()         { *m = GriddedEmissionsRequest{} }
+func (m *GriddedEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GriddedEmissionsRequest) ProtoMessage()    {}
+func (*GriddedEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{15}
+}
+func (m *GriddedEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedEmissionsRequest.Unmarshal(m, b)
+}
+func (m *GriddedEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedEmissionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GriddedEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedEmissionsRequest.Merge(dst, src)
+}
+func (m *GriddedEmissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GriddedEmissionsRequest.Size(m)
+}
+func (m *GriddedEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedEmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedEmissionsRequest proto.InternalMessageInfo
+
+func (m *GriddedEmissionsRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *GriddedEmissionsRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *GriddedEmissionsRequest) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *GriddedEmissionsRequest) GetEmissionAmount() float64 {
+	if m != nil {
+		return m.EmissionAmount
+	}
+	return 0
+}
+
+func (m *GriddedEmissionsRequest) GetEmissionUnit() string {
+	if m != nil {
+		return m.EmissionUnit
+	}
+	return ""
+}
+
+func (m *GriddedEmissionsRequest) GetEncoding() GridEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedEmissionsResponse struct {
+	Polygons  []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	Emissions []float64  `protobuf:"fixed64,2,rep,packed,name=Emissions,proto3" json:"Emissions,omitempty"`
+	// Grid and Values replace Polygons and Emissions when the
+	// request specifies CompactEncoding.
+	Grid                 *CompactGrid `protobuf:"bytes,3,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values               []float32    `protobuf:"fixed32,4,rep,packed,name=Values,proto3" json:"Values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GriddedEmissionsResponse) Reset()         { *m = GriddedEmissionsResponse{} }
+func (m *GriddedEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GriddedEmissionsResponse) ProtoMessage()    {}
+func (*GriddedEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{16}
+}
+func (m *GriddedEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedEmissionsResponse.Unmarshal(m, b)
+}
+func (m *GriddedEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedEmissionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GriddedEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedEmissionsResponse.Merge(dst, src)
+}
+func (m *GriddedEmissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GriddedEmissionsResponse.Size(m)
+}
+func (m *GriddedEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedEmissionsResponse proto.InternalMessageInfo
+
+func (m *GriddedEmissionsResponse) GetPolygons() []*Polygon {
+	if m != nil {
+		return m.Polygons
+	}
+	return nil
+}
+
+func (m *GriddedEmissionsResponse) GetEmissions() []float64 {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
+}
+
+func (m *GriddedEmissionsResponse) GetGrid() *CompactGrid {
+	if m != nil {
+		return m.Grid
+	}
+	return nil
+}
+
+func (m *GriddedEmissionsResponse) GetValues() []float32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type GriddedConcentrationsRequest struct {
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// Scenario optionally specifies a source that emits several pollutants
+	// at once. If it is set, concentrations are calculated for the combined
+	// emissions of all of the pollutants in the scenario, and Emission,
+	// EmissionAmount and EmissionUnit are ignored.
+	Scenario *EmissionScenario `protobuf:"bytes,6,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	// TotalPM25 specifies that Concentrations should hold total PM2.5,
+	// the sum of all PM2.5 species, resulting from emissions of every
+	// precursor at the requested rate rather than only the species that
+	// corresponds to Emission.
+	TotalPM25 bool `protobuf:"varint,7,opt,name=TotalPM25,proto3" json:"TotalPM25,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding             GridEncoding `protobuf:"varint,8,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GriddedConcentrationsRequest) Reset()         { *m = GriddedConcentrationsRequest{} }
+func (m *GriddedConcentrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GriddedConcentrationsRequest) ProtoMessage()    {}
+func (*GriddedConcentrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{17}
+}
+func (m *GriddedConcentrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedConcentrationsRequest.Unmarshal(m, b)
+}
+func (m *GriddedConcentrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedConcentrationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GriddedConcentrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedConcentrationsRequest.Merge(dst, src)
+}
+func (m *GriddedConcentrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GriddedConcentrationsRequest.Size(m)
+}
+func (m *GriddedConcentrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedConcentrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedConcentrationsRequest proto.InternalMessageInfo
+
+func (m *GriddedConcentrationsRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *GriddedConcentrationsRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *GriddedConcentrationsRequest) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *GriddedConcentrationsRequest) GetEmissionAmount() float64 {
+	if m != nil {
+		return m.EmissionAmount
+	}
+	return 0
+}
+
+func (m *GriddedConcentrationsRequest) GetEmissionUnit() string {
+	if m != nil {
+		return m.EmissionUnit
+	}
+	return ""
+}
+
+func (m *GriddedConcentrationsRequest) GetScenario() *EmissionScenario {
+	if m != nil {
+		return m.Scenario
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsRequest) GetTotalPM25() bool {
+	if m != nil {
+		return m.TotalPM25
+	}
+	return false
+}
+
+func (m *GriddedConcentrationsRequest) GetEncoding() GridEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedConcentrationsResponse struct {
+	Polygons []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	// Concentrations holds the concentrations of the PM2.5 species that
+	// corresponds to the requested emission, or of total PM2.5 if the
+	// request specifies a Scenario or TotalPM25.
+	Concentrations []float64 `protobuf:"fixed64,2,rep,packed,name=Concentrations,proto3" json:"Concentrations,omitempty"`
+	// The fields below hold the concentrations of each PM2.5 species.
+	// They are only set if the request specifies a Scenario.
+	PrimaryPM25 []float64 `protobuf:"fixed64,3,rep,packed,name=PrimaryPM25,proto3" json:"PrimaryPM25,omitempty"`
+	PNH4        []float64 `protobuf:"fixed64,4,rep,packed,name=PNH4,proto3" json:"PNH4,omitempty"`
+	PNO3        []float64 `protobuf:"fixed64,5,rep,packed,name=PNO3,proto3" json:"PNO3,omitempty"`
+	PSO4        []float64 `protobuf:"fixed64,6,rep,packed,name=PSO4,proto3" json:"PSO4,omitempty"`
+	SOA         []float64 `protobuf:"fixed64,7,rep,packed,name=SOA,proto3" json:"SOA,omitempty"`
+	// BaselineTotalPM25 holds the total PM2.5 concentrations from all
+	// sources in the model input data.
+	BaselineTotalPM25 []float64 `protobuf:"fixed64,9,rep,packed,name=BaselineTotalPM25,proto3" json:"BaselineTotalPM25,omitempty"`
+	// When the request specifies CompactEncoding, Grid replaces Polygons,
+	// and the single-precision fields below replace Concentrations and
+	// the double-precision fields with the same name without "Values".
+	Grid                    *CompactGrid `protobuf:"bytes,10,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values                  []float32    `protobuf:"fixed32,11,rep,packed,name=Values,proto3" json:"Values,omitempty"`
+	PrimaryPM25Values       []float32    `protobuf:"fixed32,12,rep,packed,name=PrimaryPM25Values,proto3" json:"PrimaryPM25Values,omitempty"`
+	PNH4Values              []float32    `protobuf:"fixed32,13,rep,packed,name=PNH4Values,proto3" json:"PNH4Values,omitempty"`
+	PNO3Values              []float32    `protobuf:"fixed32,14,rep,packed,name=PNO3Values,proto3" json:"PNO3Values,omitempty"`
+	PSO4Values              []float32    `protobuf:"fixed32,15,rep,packed,name=PSO4Values,proto3" json:"PSO4Values,omitempty"`
+	SOAValues               []float32    `protobuf:"fixed32,16,rep,packed,name=SOAValues,proto3" json:"SOAValues,omitempty"`
+	BaselineTotalPM25Values []float32    `protobuf:"fixed32,17,rep,packed,name=BaselineTotalPM25Values,proto3" json:"BaselineTotalPM25Values,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}     `json:"-"`
+	XXX_unrecognized        []byte       `json:"-"`
+	XXX_sizecache           int32        `json:"-"`
+}
+
+func (m *GriddedConcentrationsResponse) Reset()         { *m = GriddedConcentrationsResponse{} }
+func (m *GriddedConcentrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GriddedConcentrationsResponse) ProtoMessage()    {}
+func (*GriddedConcentrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{18}
+}
+func (m *GriddedConcentrationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedConcentrationsResponse.Unmarshal(m, b)
+}
+func (m *GriddedConcentrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedConcentrationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GriddedConcentrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedConcentrationsResponse.Merge(dst, src)
+}
+func (m *GriddedConcentrationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GriddedConcentrationsResponse.Size(m)
+}
+func (m *GriddedConcentrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedConcentrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedConcentrationsResponse proto.InternalMessageInfo
+
+func (m *GriddedConcentrationsResponse) GetPolygons() []*Polygon {
+	if m != nil {
+		return m.Polygons
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetConcentrations() []float64 {
+	if m != nil {
+		return m.Concentrations
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPrimaryPM25() []float64 {
+	if m != nil {
+		return m.PrimaryPM25
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPNH4() []float64 {
+	if m != nil {
+		return m.PNH4
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPNO3() []float64 {
+	if m != nil {
+		return m.PNO3
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPSO4() []float64 {
+	if m != nil {
+		return m.PSO4
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetSOA() []float64 {
+	if m != nil {
+		return m.SOA
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetBaselineTotalPM25() []float64 {
+	if m != nil {
+		return m.BaselineTotalPM25
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetGrid() *CompactGrid {
+	if m != nil {
+		return m.Grid
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetValues() []float32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPrimaryPM25Values() []float32 {
+	if m != nil {
+		return m.PrimaryPM25Values
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPNH4Values() []float32 {
+	if m != nil {
+		return m.PNH4Values
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPNO3Values() []float32 {
+	if m != nil {
+		return m.PNO3Values
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetPSO4Values() []float32 {
+	if m != nil {
+		return m.PSO4Values
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetSOAValues() []float32 {
+	if m != nil {
+		return m.SOAValues
+	}
+	return nil
+}
+
+func (m *GriddedConcentrationsResponse) GetBaselineTotalPM25Values() []float32 {
+	if m != nil {
+		return m.BaselineTotalPM25Values
+	}
+	return nil
+}
+
+// EmissionScenario specifies a source that emits a separate amount of
+// each pollutant.
+type EmissionScenario struct {
+	Emissions            []*PollutantEmission `protobuf:"bytes,1,rep,name=Emissions,proto3" json:"Emissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EmissionScenario) Reset()         { *m = EmissionScenario{} }
+func (m *EmissionScenario) String() string { return proto.CompactTextString(m) }
+func (*EmissionScenario) ProtoMessage()    {}
+func (*EmissionScenario) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{19}
+}
+func (m *EmissionScenario) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmissionScenario.Unmarshal(m, b)
+}
+func (m *EmissionScenario) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmissionScenario.Marshal(b, m, deterministic)
+}
+func (dst *EmissionScenario) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScenario.Merge(dst, src)
+}
+func (m *EmissionScenario) XXX_Size() int {
+	return xxx_messageInfo_EmissionScenario.Size(m)
+}
+func (m *EmissionScenario) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScenario.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScenario proto.InternalMessageInfo
+
+func (m *EmissionScenario) GetEmissions() []*PollutantEmission {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
+}
+
+// PollutantEmission specifies the emissions rate of a single pollutant.
+type PollutantEmission struct {
+	Emission Emission `protobuf:"varint,1,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Amount is the amount of emissions, in units of Unit.
+	// If unset, an amount of 1 Unit is assumed.
+	Amount float64 `protobuf:"fixed64,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Unit is the unit of Amount, for example "t/yr", "kg/day", or "kt/yr".
+	// If unset, "kt/yr" is assumed.
+	Unit                 string   `protobuf:"bytes,3,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollutantEmission) Reset()         { *m = PollutantEmission{} }
+func (m *PollutantEmission) String() string { return proto.CompactTextString(m) }
+func (*PollutantEmission) ProtoMessage()    {}
+func (*PollutantEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{20}
+}
+func (m *PollutantEmission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollutantEmission.Unmarshal(m, b)
+}
+func (m *PollutantEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollutantEmission.Marshal(b, m, deterministic)
+}
+func (dst *PollutantEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollutantEmission.Merge(dst, src)
+}
+func (m *PollutantEmission) XXX_Size() int {
+	return xxx_messageInfo_PollutantEmission.Size(m)
+}
+func (m *PollutantEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollutantEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollutantEmission proto.InternalMessageInfo
+
+func (m *PollutantEmission) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *PollutantEmission) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PollutantEmission) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type GriddedPopulationRequest struct {
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Encoding specifies how the grid cells and values in the
+	// response are represented.
+	Encoding             GridEncoding `protobuf:"varint,4,opt,name=Encoding,proto3,enum=cityaqrpc.GridEncoding" json:"Encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GriddedPopulationRequest) Reset()         { *m = GriddedPopulationRequest{} }
+func (m *GriddedPopulationRequest) String() string { return proto.CompactTextString(m) }
+func (*GriddedPopulationRequest) ProtoMessage()    {}
+func (*GriddedPopulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{21}
+}
+func (m *GriddedPopulationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedPopulationRequest.Unmarshal(m, b)
+}
+func (m *GriddedPopulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedPopulationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GriddedPopulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedPopulationRequest.Merge(dst, src)
+}
+func (m *GriddedPopulationRequest) XXX_Size() int {
+	return xxx_messageInfo_GriddedPopulationRequest.Size(m)
+}
+func (m *GriddedPopulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedPopulationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedPopulationRequest proto.InternalMessageInfo
+
+func (m *GriddedPopulationRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *GriddedPopulationRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *GriddedPopulationRequest) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *GriddedPopulationRequest) GetEncoding() GridEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return GridEncoding_PolygonEncoding
+}
+
+type GriddedPopulationResponse struct {
+	Polygons   []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
+	Population []float64  `protobuf:"fixed64,2,rep,packed,name=Population,proto3" json:"Population,omitempty"`
+	// MortalityRate is the all-cause mortality rate,
+	// in deaths per 100,000 people per year.
+	MortalityRate []float64 `protobuf:"fixed64,3,rep,packed,name=MortalityRate,proto3" json:"MortalityRate,omitempty"`
+	// Grid, Values, and MortalityRateValues replace Polygons,
+	// Population, and MortalityRate when the request specifies
+	// CompactEncoding.
+	Grid                 *CompactGrid `protobuf:"bytes,4,opt,name=Grid,proto3" json:"Grid,omitempty"`
+	Values               []float32    `protobuf:"fixed32,5,rep,packed,name=Values,proto3" json:"Values,omitempty"`
+	MortalityRateValues  []float32    `protobuf:"fixed32,6,rep,packed,name=MortalityRateValues,proto3" json:"MortalityRateValues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GriddedPopulationResponse) Reset()         { *m = GriddedPopulationResponse{} }
+func (m *GriddedPopulationResponse) String() string { return proto.CompactTextString(m) }
+func (*GriddedPopulationResponse) ProtoMessage()    {}
+func (*GriddedPopulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{22}
+}
+func (m *GriddedPopulationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GriddedPopulationResponse.Unmarshal(m, b)
+}
+func (m *GriddedPopulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GriddedPopulationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GriddedPopulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GriddedPopulationResponse.Merge(dst, src)
+}
+func (m *GriddedPopulationResponse) XXX_Size() int {
+	return xxx_messageInfo_GriddedPopulationResponse.Size(m)
+}
+func (m *GriddedPopulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GriddedPopulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GriddedPopulationResponse proto.InternalMessageInfo
+
+func (m *GriddedPopulationResponse) GetPolygons() []*Polygon {
+	if m != nil {
+		return m.Polygons
+	}
+	return nil
+}
+
+func (m *GriddedPopulationResponse) GetPopulation() []float64 {
+	if m != nil {
+		return m.Population
+	}
+	return nil
+}
+
+func (m *GriddedPopulationResponse) GetMortalityRate() []float64 {
+	if m != nil {
+		return m.MortalityRate
+	}
+	return nil
+}
+
+func (m *GriddedPopulationResponse) GetGrid() *CompactGrid {
+	if m != nil {
+		return m.Grid
+	}
+	return nil
+}
+
+func (m *GriddedPopulationResponse) GetValues() []float32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *GriddedPopulationResponse) GetMortalityRateValues() []float32 {
+	if m != nil {
+		return m.MortalityRateValues
+	}
+	return nil
+}
+
+// CompactGrid describes the cells of a grid more compactly than
+// a list of polygons. Cells are either described by the regular
+// lattice specified by X0, Y0, Dx, Dy, Nx, and Ny, or, if Nx is zero,
+// individually by CellBounds.
+type CompactGrid struct {
+	// X0 and Y0 are the coordinates of the lower-left corner of the
+	// grid, Dx and Dy are the width and height of each cell, and Nx and
+	// Ny are the numbers of columns and rows. Cells are ordered by row,
+	// starting with the lower-left cell.
+	X0 float64 `protobuf:"fixed64,1,opt,name=X0,proto3" json:"X0,omitempty"`
+	Y0 float64 `protobuf:"fixed64,2,opt,name=Y0,proto3" json:"Y0,omitempty"`
+	Dx float64 `protobuf:"fixed64,3,opt,name=Dx,proto3" json:"Dx,omitempty"`
+	Dy float64 `protobuf:"fixed64,4,opt,name=Dy,proto3" json:"Dy,omitempty"`
+	Nx int32   `protobuf:"varint,5,opt,name=Nx,proto3" json:"Nx,omitempty"`
+	Ny int32   `protobuf:"varint,6,opt,name=Ny,proto3" json:"Ny,omitempty"`
+	// CellBounds holds the MinX, MinY, MaxX, and MaxY coordinates
+	// of each rectangular cell in turn, for grids such as the InMAP
+	// variable-resolution grid that are not regular.
+	CellBounds           []float64 `protobuf:"fixed64,7,rep,packed,name=CellBounds,proto3" json:"CellBounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CompactGrid) Reset()         { *m = CompactGrid{} }
+func (m *CompactGrid) String() string { return proto.CompactTextString(m) }
+func (*CompactGrid) ProtoMessage()    {}
+func (*CompactGrid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{23}
+}
+func (m *CompactGrid) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactGrid.Unmarshal(m, b)
+}
+func (m *CompactGrid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactGrid.Marshal(b, m, deterministic)
+}
+func (dst *CompactGrid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactGrid.Merge(dst, src)
+}
+func (m *CompactGrid) XXX_Size() int {
+	return xxx_messageInfo_CompactGrid.Size(m)
+}
+func (m *CompactGrid) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactGrid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactGrid proto.InternalMessageInfo
+
+func (m *CompactGrid) GetX0() float64 {
+	if m != nil {
+		return m.X0
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetY0() float64 {
+	if m != nil {
+		return m.Y0
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetDx() float64 {
+	if m != nil {
+		return m.Dx
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetDy() float64 {
+	if m != nil {
+		return m.Dy
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetNx() int32 {
+	if m != nil {
+		return m.Nx
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetNy() int32 {
+	if m != nil {
+		return m.Ny
+	}
+	return 0
+}
+
+func (m *CompactGrid) GetCellBounds() []float64 {
+	if m != nil {
+		return m.CellBounds
+	}
+	return nil
+}
+
+type ImpactSummaryRequest struct {
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// EmissionAmount is the amount of emissions, in units of
+	// EmissionUnit. If unset, an amount of 1 EmissionUnit is assumed.
+	EmissionAmount float64 `protobuf:"fixed64,4,opt,name=EmissionAmount,proto3" json:"EmissionAmount,omitempty"`
+	// EmissionUnit is the unit of EmissionAmount, for example
+	// "t/yr", "kg/day", or "kt/yr". Masses and times can be given in
+	// g, t or tonnes and s, min, h, day or yr, with SI prefixes such as
+	// k or M. If unset, "kt/yr" is assumed.
+	EmissionUnit string `protobuf:"bytes,5,opt,name=EmissionUnit,proto3" json:"EmissionUnit,omitempty"`
+	// TotalPM25 specifies that the summary should be calculated for
+	// total PM2.5 resulting from emissions of every precursor, rather
+	// than only the PM2.5 species that corresponds to Emission.
+	TotalPM25 bool `protobuf:"varint,6,opt,name=TotalPM25,proto3" json:"TotalPM25,omitempty"`
+	// ConcentrationResponse specifies the concentration-response function
+	// used to calculate premature deaths. If unset, Krewski2009 is used.
+	ConcentrationResponse ConcentrationResponse `protobuf:"varint,7,opt,name=ConcentrationResponse,proto3,enum=cityaqrpc.ConcentrationResponse" json:"ConcentrationResponse,omitempty"`
+	// Scenario optionally specifies a source that emits several pollutants
+	// at once. If it is set, the summary is calculated for total PM2.5
+	// resulting from the combined emissions of all of the pollutants in
+	// the scenario, and Emission, EmissionAmount, EmissionUnit and
+	// TotalPM25 are ignored.
+	Scenario             *EmissionScenario `protobuf:"bytes,8,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImpactSummaryRequest) Reset()         { *m = ImpactSummaryRequest{} }
+func (m *ImpactSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*ImpactSummaryRequest) ProtoMessage()    {}
+func (*ImpactSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{24}
+}
+func (m *ImpactSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpactSummaryRequest.Unmarshal(m, b)
+}
+func (m *ImpactSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpactSummaryRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImpactSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpactSummaryRequest.Merge(dst, src)
+}
+func (m *ImpactSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_ImpactSummaryRequest.Size(m)
+}
+func (m *ImpactSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpactSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpactSummaryRequest proto.InternalMessageInfo
+
+func (m *ImpactSummaryRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *ImpactSummaryRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ImpactSummaryRequest) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *ImpactSummaryRequest) GetEmissionAmount() float64 {
+	if m != nil {
+		return m.EmissionAmount
+	}
+	return 0
+}
+
+func (m *ImpactSummaryRequest) GetEmissionUnit() string {
+	if m != nil {
+		return m.EmissionUnit
+	}
+	return ""
+}
+
+func (m *ImpactSummaryRequest) GetTotalPM25() bool {
+	if m != nil {
+		return m.TotalPM25
+	}
+	return false
+}
+
+func (m *ImpactSummaryRequest) GetConcentrationResponse() ConcentrationResponse {
+	if m != nil {
+		return m.ConcentrationResponse
+	}
+	return ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE
+}
+
+func (m *ImpactSummaryRequest) GetScenario() *EmissionScenario {
+	if m != nil {
+		return m.Scenario
+	}
+	return nil
+}
+
+type ImpactSummaryResponse struct {
+	// Population is the total population.
+	Population float64 `protobuf:"fixed64,1,opt,name=Population,proto3" json:"Population,omitempty"`
+	// CityPopulation is the total population in the city.
+	CityPopulation float64 `protobuf:"fixed64,2,opt,name=CityPopulation,proto3" json:"CityPopulation,omitempty"`
+	// CityExposure is the population-weighted average
+	// concentration in the city.
+	CityExposure float64 `protobuf:"fixed64,3,opt,name=CityExposure,proto3" json:"CityExposure,omitempty"`
+	// TotalExposure is the overall population-weighted average
+	// concentration.
+	TotalExposure float64 `protobuf:"fixed64,4,opt,name=TotalExposure,proto3" json:"TotalExposure,omitempty"`
+	// CityIF is the intake fraction in the city.
+	CityIF float64 `protobuf:"fixed64,5,opt,name=CityIF,proto3" json:"CityIF,omitempty"`
+	// TotalIF is the total intake fraction.
+	TotalIF float64 `protobuf:"fixed64,6,opt,name=TotalIF,proto3" json:"TotalIF,omitempty"`
+	// CityDeaths is the number of premature deaths per year in
+	// the city caused by the emissions.
+	CityDeaths float64 `protobuf:"fixed64,7,opt,name=CityDeaths,proto3" json:"CityDeaths,omitempty"`
+	// TotalDeaths is the total number of premature deaths per year
+	// caused by the emissions.
+	TotalDeaths          float64  `protobuf:"fixed64,8,opt,name=TotalDeaths,proto3" json:"TotalDeaths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImpactSummaryResponse) Reset()         { *m = ImpactSummaryResponse{} }
+func (m *ImpactSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*ImpactSummaryResponse) ProtoMessage()    {}
+func (*ImpactSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{25}
+}
+func (m *ImpactSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpactSummaryResponse.Unmarshal(m, b)
+}
+func (m *ImpactSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpactSummaryResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImpactSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpactSummaryResponse.Merge(dst, src)
+}
+func (m *ImpactSummaryResponse) XXX_Size() int {
+	return xxx_messageInfo_ImpactSummaryResponse.Size(m)
+}
+func (m *ImpactSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpactSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpactSummaryResponse proto.InternalMessageInfo
+
+func (m *ImpactSummaryResponse) GetPopulation() float64 {
+	if m != nil {
+		return m.Population
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetCityPopulation() float64 {
+	if m != nil {
+		return m.CityPopulation
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetCityExposure() float64 {
+	if m != nil {
+		return m.CityExposure
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetTotalExposure() float64 {
+	if m != nil {
+		return m.TotalExposure
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetCityIF() float64 {
+	if m != nil {
+		return m.CityIF
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetTotalIF() float64 {
+	if m != nil {
+		return m.TotalIF
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetCityDeaths() float64 {
+	if m != nil {
+		return m.CityDeaths
+	}
+	return 0
+}
+
+func (m *ImpactSummaryResponse) GetTotalDeaths() float64 {
+	if m != nil {
+		return m.TotalDeaths
+	}
+	return 0
+}
+
+type DamagesRequest struct {
+	CityName   string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Emission   Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// ConcentrationResponse specifies the concentration-response function
+	// used to calculate premature deaths. If unset, Krewski2009 is used.
+	ConcentrationResponse ConcentrationResponse `protobuf:"varint,4,opt,name=ConcentrationResponse,proto3,enum=cityaqrpc.ConcentrationResponse" json:"ConcentrationResponse,omitempty"`
+	// VSL is the value of a statistical life, in dollars, in the
+	// reference country.
+	VSL float64 `protobuf:"fixed64,5,opt,name=VSL,proto3" json:"VSL,omitempty"`
+	// IncomeElasticity is the income elasticity of the VSL. It is used
+	// to adjust the VSL to the country that the city is in. If it is
+	// zero, the VSL is not adjusted. Servers are not configured with
+	// income data by default, so callers that set it should usually
+	// also set ReferenceIncome and CountryIncomes.
+	IncomeElasticity float64 `protobuf:"fixed64,6,opt,name=IncomeElasticity,proto3" json:"IncomeElasticity,omitempty"`
+	// ReferenceIncome is the per-capita income in the reference country,
+	// in US dollars. If it is unset, the server's income data for its
+	// reference country is used.
+	ReferenceIncome float64 `protobuf:"fixed64,7,opt,name=ReferenceIncome,proto3" json:"ReferenceIncome,omitempty"`
+	// CountryIncomes optionally holds the per-capita income in each
+	// country, in US dollars, keyed by country name. The server's income
+	// data is used for the country that the city is in if it isn't
+	// included.
+	CountryIncomes map[string]float64 `protobuf:"bytes,8,rep,name=CountryIncomes,proto3" json:"CountryIncomes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// DiscountRate is the annual rate, for example 0.03, used to discount
+	// deaths that occur in the years after exposure.
+	DiscountRate         float64  `protobuf:"fixed64,9,opt,name=DiscountRate,proto3" json:"DiscountRate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DamagesRequest) Reset()         { *m = DamagesRequest{} }
+func (m *DamagesRequest) String() string { return proto.CompactTextString(m) }
+func (*DamagesRequest) ProtoMessage()    {}
+func (*DamagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{26}
+}
+func (m *DamagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagesRequest.Unmarshal(m, b)
+}
+func (m *DamagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *DamagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagesRequest.Merge(dst, src)
+}
+func (m *DamagesRequest) XXX_Size() int {
+	return xxx_messageInfo_DamagesRequest.Size(m)
+}
+func (m *DamagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagesRequest proto.InternalMessageInfo
+
+func (m *DamagesRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *DamagesRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *DamagesRequest) GetEmission() Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *DamagesRequest) GetConcentrationResponse() ConcentrationResponse {
+	if m != nil {
+		return m.ConcentrationResponse
+	}
+	return ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE
+}
+
+func (m *DamagesRequest) GetVSL() float64 {
+	if m != nil {
+		return m.VSL
+	}
+	return 0
+}
+
+func (m *DamagesRequest) GetIncomeElasticity() float64 {
+	if m != nil {
+		return m.IncomeElasticity
+	}
+	return 0
+}
+
+func (m *DamagesRequest) GetReferenceIncome() float64 {
+	if m != nil {
+		return m.ReferenceIncome
+	}
+	return 0
+}
+
+func (m *DamagesRequest) GetCountryIncomes() map[string]float64 {
+	if m != nil {
+		return m.CountryIncomes
+	}
+	return nil
+}
+
+func (m *DamagesRequest) GetDiscountRate() float64 {
+	if m != nil {
+		return m.DiscountRate
+	}
+	return 0
+}
+
+type DamagesResponse struct {
+	// Country is the name of the country that the city is in.
+	Country string `protobuf:"bytes,1,opt,name=Country,proto3" json:"Country,omitempty"`
+	// VSL is the value of a statistical life, in dollars, after
+	// adjusting for income in Country.
+	VSL float64 `protobuf:"fixed64,2,opt,name=VSL,proto3" json:"VSL,omitempty"`
+	// CityDamages is the monetized value of premature deaths in the
+	// city, in dollars per tonne of emissions.
+	CityDamages float64 `protobuf:"fixed64,3,opt,name=CityDamages,proto3" json:"CityDamages,omitempty"`
+	// TotalDamages is the total monetized value of premature deaths,
+	// in dollars per tonne of emissions.
+	TotalDamages         float64  `protobuf:"fixed64,4,opt,name=TotalDamages,proto3" json:"TotalDamages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DamagesResponse) Reset()         { *m = DamagesResponse{} }
+func (m *DamagesResponse) String() string { return proto.CompactTextString(m) }
+func (*DamagesResponse) ProtoMessage()    {}
+func (*DamagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{27}
+}
+func (m *DamagesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DamagesResponse.Unmarshal(m, b)
+}
+func (m *DamagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DamagesResponse.Marshal(b, m, deterministic)
+}
+func (dst *DamagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DamagesResponse.Merge(dst, src)
+}
+func (m *DamagesResponse) XXX_Size() int {
+	return xxx_messageInfo_DamagesResponse.Size(m)
+}
+func (m *DamagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DamagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DamagesResponse proto.InternalMessageInfo
+
+func (m *DamagesResponse) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *DamagesResponse) GetVSL() float64 {
+	if m != nil {
+		return m.VSL
+	}
+	return 0
+}
+
+func (m *DamagesResponse) GetCityDamages() float64 {
+	if m != nil {
+		return m.CityDamages
+	}
+	return 0
+}
+
+func (m *DamagesResponse) GetTotalDamages() float64 {
+	if m != nil {
+		return m.TotalDamages
+	}
+	return 0
+}
+
+type JobProgressRequest struct {
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Emission is included for consistency with
+	// GriddedConcentrationsRequest. The concentrations resulting from
+	// all emissions from a source type are calculated by the same job.
+	Emission Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	// Scenario specifies the emissions scenario, if any, that the job
+	// is modeling.
+	Scenario             *EmissionScenario `protobuf:"bytes,4,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JobProgressRequest) Reset()         { *m = JobProgressRequest{} }
+func (m *JobProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*JobProgressRequest) ProtoMessage()    {}
+func (*JobProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{28}
+}
+func (m *JobProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobProgressRequest.Unmarshal(m, b)
+}
+func (m *JobProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobProgressRequest.Marshal(b, m, deterministic)
+}
+func (dst *JobProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobProgressRequest.Merge(dst, src)
+}
+func (m *JobProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_JobProgressRequest.Size(m)
+}
+func (m *JobProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobProgressRequest proto.InternalMessageInfo
+
+func (m *JobProgressRequest) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *JobProgressRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *JobProgressRequest) GetEmission() Emission {
 	if m != nil {
 		return m.Emission
 	}
-	return Emission_UNKNOWN_EMISSION
+	return Emission_UNKNOWN_EMISSION
+}
+
+func (m *JobProgressRequest) GetScenario() *EmissionScenario {
+	if m != nil {
+		return m.Scenario
+	}
+	return nil
+}
+
+type JobProgressResponse struct {
+	State JobState `protobuf:"varint,1,opt,name=State,proto3,enum=cityaqrpc.JobState" json:"State,omitempty"`
+	// Message holds additional information about the state, if any.
+	Message              string   `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobProgressResponse) Reset()         { *m = JobProgressResponse{} }
+func (m *JobProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*JobProgressResponse) ProtoMessage()    {}
+func (*JobProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{29}
+}
+func (m *JobProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobProgressResponse.Unmarshal(m, b)
+}
+func (m *JobProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobProgressResponse.Marshal(b, m, deterministic)
+}
+func (dst *JobProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobProgressResponse.Merge(dst, src)
+}
+func (m *JobProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_JobProgressResponse.Size(m)
+}
+func (m *JobProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobProgressResponse proto.InternalMessageInfo
+
+func (m *JobProgressResponse) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_UNKNOWN_JOBSTATE
 }
 
-type GriddedEmissionsResponse struct {
-	Polygons             []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Emissions            []float64  `protobuf:"fixed64,2,rep,packed,name=Emissions,proto3" json:"Emissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *JobProgressResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *GriddedEmissionsResponse) Reset()         { *m = GriddedEmissionsResponse{} }
-func (m *GriddedEmissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GriddedEmissionsResponse) ProtoMessage()    {}
-func (*GriddedEmissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{8}
+type SubmitConcentrationJobRequest struct {
+	CityName   string `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario specifies the emissions scenario, if any, to model.
+	Scenario             *EmissionScenario `protobuf:"bytes,3,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *GriddedEmissionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedEmissionsResponse.Unmarshal(m, b)
+
+func (m *SubmitConcentrationJobRequest) Reset()         { *m = SubmitConcentrationJobRequest{} }
+func (m *SubmitConcentrationJobRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitConcentrationJobRequest) ProtoMessage()    {}
+func (*SubmitConcentrationJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{30}
 }
-func (m *GriddedEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedEmissionsResponse.Marshal(b, m, deterministic)
+func (m *SubmitConcentrationJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitConcentrationJobRequest.Unmarshal(m, b)
 }
-func (dst *GriddedEmissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedEmissionsResponse.Merge(dst, src)
+func (m *SubmitConcentrationJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitConcentrationJobRequest.Marshal(b, m, deterministic)
 }
-func (m *GriddedEmissionsResponse) XXX_Size() int {
-	return xxx_messageInfo_GriddedEmissionsResponse.Size(m)
+func (dst *SubmitConcentrationJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitConcentrationJobRequest.Merge(dst, src)
 }
-func (m *GriddedEmissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedEmissionsResponse.DiscardUnknown(m)
+func (m *SubmitConcentrationJobRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitConcentrationJobRequest.Size(m)
+}
+func (m *SubmitConcentrationJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitConcentrationJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedEmissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubmitConcentrationJobRequest proto.InternalMessageInfo
 
-func (m *GriddedEmissionsResponse) GetPolygons() []*Polygon {
+func (m *SubmitConcentrationJobRequest) GetCityName() string {
 	if m != nil {
-		return m.Polygons
+		return m.CityName
 	}
-	return nil
+	return ""
 }
 
-func (m *GriddedEmissionsResponse) GetEmissions() []float64 {
+func (m *SubmitConcentrationJobRequest) GetSourceType() string {
 	if m != nil {
-		return m.Emissions
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *SubmitConcentrationJobRequest) GetScenario() *EmissionScenario {
+	if m != nil {
+		return m.Scenario
 	}
 	return nil
 }
 
-type GriddedConcentrationsRequest struct {
-	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType           string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission             Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+type Job struct {
+	// ID uniquely identifies the job.
+	ID         string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CityName   string   `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string   `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	State      JobState `protobuf:"varint,4,opt,name=State,proto3,enum=cityaqrpc.JobState" json:"State,omitempty"`
+	// Message holds the most recent status message for the job, if any.
+	Message string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Updated is the time of the most recent change in State,
+	// in seconds since the Unix epoch.
+	Updated              int64    `protobuf:"varint,6,opt,name=Updated,proto3" json:"Updated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GriddedConcentrationsRequest) Reset()         { *m = GriddedConcentrationsRequest{} }
-func (m *GriddedConcentrationsRequest) String() string { return proto.CompactTextString(m) }
-func (*GriddedConcentrationsRequest) ProtoMessage()    {}
-func (*GriddedConcentrationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{9}
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{31}
 }
-func (m *GriddedConcentrationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedConcentrationsRequest.Unmarshal(m, b)
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
 }
-func (m *GriddedConcentrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedConcentrationsRequest.Marshal(b, m, deterministic)
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
 }
-func (dst *GriddedConcentrationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedConcentrationsRequest.Merge(dst, src)
+func (dst *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(dst, src)
 }
-func (m *GriddedConcentrationsRequest) XXX_Size() int {
-	return xxx_messageInfo_GriddedConcentrationsRequest.Size(m)
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
 }
-func (m *GriddedConcentrationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedConcentrationsRequest.DiscardUnknown(m)
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedConcentrationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_Job proto.InternalMessageInfo
 
-func (m *GriddedConcentrationsRequest) GetCityName() string {
+func (m *Job) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Job) GetCityName() string {
 	if m != nil {
 		return m.CityName
 	}
 	return ""
 }
 
-func (m *GriddedConcentrationsRequest) GetSourceType() string {
+func (m *Job) GetSourceType() string {
 	if m != nil {
 		return m.SourceType
 	}
 	return ""
 }
 
-func (m *GriddedConcentrationsRequest) GetEmission() Emission {
+func (m *Job) GetState() JobState {
 	if m != nil {
-		return m.Emission
+		return m.State
 	}
-	return Emission_UNKNOWN_EMISSION
+	return JobState_UNKNOWN_JOBSTATE
 }
 
-type GriddedConcentrationsResponse struct {
-	Polygons             []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Concentrations       []float64  `protobuf:"fixed64,2,rep,packed,name=Concentrations,proto3" json:"Concentrations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *Job) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *GriddedConcentrationsResponse) Reset()         { *m = GriddedConcentrationsResponse{} }
-func (m *GriddedConcentrationsResponse) String() string { return proto.CompactTextString(m) }
-func (*GriddedConcentrationsResponse) ProtoMessage()    {}
-func (*GriddedConcentrationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{10}
+func (m *Job) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
 }
-func (m *GriddedConcentrationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedConcentrationsResponse.Unmarshal(m, b)
+
+type GetJobRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GriddedConcentrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedConcentrationsResponse.Marshal(b, m, deterministic)
+
+func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
+func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobRequest) ProtoMessage()    {}
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{32}
 }
-func (dst *GriddedConcentrationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedConcentrationsResponse.Merge(dst, src)
+func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobRequest.Unmarshal(m, b)
 }
-func (m *GriddedConcentrationsResponse) XXX_Size() int {
-	return xxx_messageInfo_GriddedConcentrationsResponse.Size(m)
+func (m *GetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobRequest.Marshal(b, m, deterministic)
 }
-func (m *GriddedConcentrationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedConcentrationsResponse.DiscardUnknown(m)
+func (dst *GetJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobRequest.Merge(dst, src)
+}
+func (m *GetJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobRequest.Size(m)
+}
+func (m *GetJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedConcentrationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetJobRequest proto.InternalMessageInfo
 
-func (m *GriddedConcentrationsResponse) GetPolygons() []*Polygon {
+func (m *GetJobRequest) GetID() string {
 	if m != nil {
-		return m.Polygons
+		return m.ID
 	}
-	return nil
+	return ""
 }
 
-func (m *GriddedConcentrationsResponse) GetConcentrations() []float64 {
+type CancelJobRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelJobRequest) Reset()         { *m = CancelJobRequest{} }
+func (m *CancelJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelJobRequest) ProtoMessage()    {}
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{33}
+}
+func (m *CancelJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobRequest.Unmarshal(m, b)
+}
+func (m *CancelJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobRequest.Merge(dst, src)
+}
+func (m *CancelJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelJobRequest.Size(m)
+}
+func (m *CancelJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelJobRequest proto.InternalMessageInfo
+
+func (m *CancelJobRequest) GetID() string {
 	if m != nil {
-		return m.Concentrations
+		return m.ID
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{34}
+}
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsRequest.Unmarshal(m, b)
+}
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(dst, src)
+}
+func (m *ListJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJobsRequest.Size(m)
+}
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
+
+type ListJobsResponse struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()    {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{35}
+}
+func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsResponse.Unmarshal(m, b)
+}
+func (m *ListJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsResponse.Merge(dst, src)
+}
+func (m *ListJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJobsResponse.Size(m)
+}
+func (m *ListJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsResponse proto.InternalMessageInfo
+
+func (m *ListJobsResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
 	}
 	return nil
 }
 
-type GriddedPopulationRequest struct {
+type ListCachedResultsRequest struct {
+	// CityName and SourceType, if specified, limit the results to those
+	// for the given city and source type. CityName may be the name or
+	// the ID of the city.
 	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
 	SourceType           string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission             Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GriddedPopulationRequest) Reset()         { *m = GriddedPopulationRequest{} }
-func (m *GriddedPopulationRequest) String() string { return proto.CompactTextString(m) }
-func (*GriddedPopulationRequest) ProtoMessage()    {}
-func (*GriddedPopulationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{11}
+func (m *ListCachedResultsRequest) Reset()         { *m = ListCachedResultsRequest{} }
+func (m *ListCachedResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCachedResultsRequest) ProtoMessage()    {}
+func (*ListCachedResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{36}
 }
-func (m *GriddedPopulationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedPopulationRequest.Unmarshal(m, b)
+func (m *ListCachedResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCachedResultsRequest.Unmarshal(m, b)
 }
-func (m *GriddedPopulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedPopulationRequest.Marshal(b, m, deterministic)
+func (m *ListCachedResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCachedResultsRequest.Marshal(b, m, deterministic)
 }
-func (dst *GriddedPopulationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedPopulationRequest.Merge(dst, src)
+func (dst *ListCachedResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCachedResultsRequest.Merge(dst, src)
 }
-func (m *GriddedPopulationRequest) XXX_Size() int {
-	return xxx_messageInfo_GriddedPopulationRequest.Size(m)
+func (m *ListCachedResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCachedResultsRequest.Size(m)
 }
-func (m *GriddedPopulationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedPopulationRequest.DiscardUnknown(m)
+func (m *ListCachedResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCachedResultsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedPopulationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListCachedResultsRequest proto.InternalMessageInfo
 
-func (m *GriddedPopulationRequest) GetCityName() string {
+func (m *ListCachedResultsRequest) GetCityName() string {
 	if m != nil {
 		return m.CityName
 	}
 	return ""
 }
 
-func (m *GriddedPopulationRequest) GetSourceType() string {
+func (m *ListCachedResultsRequest) GetSourceType() string {
 	if m != nil {
 		return m.SourceType
 	}
 	return ""
 }
 
-func (m *GriddedPopulationRequest) GetEmission() Emission {
-	if m != nil {
-		return m.Emission
-	}
-	return Emission_UNKNOWN_EMISSION
-}
-
-type GriddedPopulationResponse struct {
-	Polygons             []*Polygon `protobuf:"bytes,1,rep,name=Polygons,proto3" json:"Polygons,omitempty"`
-	Population           []float64  `protobuf:"fixed64,2,rep,packed,name=Population,proto3" json:"Population,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type ListCachedResultsResponse struct {
+	Results              []*CachedResultInfo `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *GriddedPopulationResponse) Reset()         { *m = GriddedPopulationResponse{} }
-func (m *GriddedPopulationResponse) String() string { return proto.CompactTextString(m) }
-func (*GriddedPopulationResponse) ProtoMessage()    {}
-func (*GriddedPopulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{12}
+func (m *ListCachedResultsResponse) Reset()         { *m = ListCachedResultsResponse{} }
+func (m *ListCachedResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCachedResultsResponse) ProtoMessage()    {}
+func (*ListCachedResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{37}
 }
-func (m *GriddedPopulationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GriddedPopulationResponse.Unmarshal(m, b)
+func (m *ListCachedResultsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCachedResultsResponse.Unmarshal(m, b)
 }
-func (m *GriddedPopulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GriddedPopulationResponse.Marshal(b, m, deterministic)
+func (m *ListCachedResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCachedResultsResponse.Marshal(b, m, deterministic)
 }
-func (dst *GriddedPopulationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GriddedPopulationResponse.Merge(dst, src)
+func (dst *ListCachedResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCachedResultsResponse.Merge(dst, src)
 }
-func (m *GriddedPopulationResponse) XXX_Size() int {
-	return xxx_messageInfo_GriddedPopulationResponse.Size(m)
+func (m *ListCachedResultsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCachedResultsResponse.Size(m)
 }
-func (m *GriddedPopulationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GriddedPopulationResponse.DiscardUnknown(m)
+func (m *ListCachedResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCachedResultsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GriddedPopulationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListCachedResultsResponse proto.InternalMessageInfo
 
-func (m *GriddedPopulationResponse) GetPolygons() []*Polygon {
+func (m *ListCachedResultsResponse) GetResults() []*CachedResultInfo {
 	if m != nil {
-		return m.Polygons
+		return m.Results
 	}
 	return nil
 }
 
-func (m *GriddedPopulationResponse) GetPopulation() []float64 {
+type CachedResultRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CachedResultRequest) Reset()         { *m = CachedResultRequest{} }
+func (m *CachedResultRequest) String() string { return proto.CompactTextString(m) }
+func (*CachedResultRequest) ProtoMessage()    {}
+func (*CachedResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{38}
+}
+func (m *CachedResultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CachedResultRequest.Unmarshal(m, b)
+}
+func (m *CachedResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CachedResultRequest.Marshal(b, m, deterministic)
+}
+func (dst *CachedResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachedResultRequest.Merge(dst, src)
+}
+func (m *CachedResultRequest) XXX_Size() int {
+	return xxx_messageInfo_CachedResultRequest.Size(m)
+}
+func (m *CachedResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachedResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CachedResultRequest proto.InternalMessageInfo
+
+func (m *CachedResultRequest) GetKey() string {
 	if m != nil {
-		return m.Population
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type ImpactSummaryRequest struct {
-	CityName             string   `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	SourceType           string   `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	Emission             Emission `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+type CachedResultInfo struct {
+	// Key is the cache key of the result, which is also the ID of
+	// the job that created it.
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	// CityName and SourceType are empty for results that were created
+	// by earlier versions of CityAQ.
+	CityName   string `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario is true if the result is for an emissions scenario.
+	Scenario bool `protobuf:"varint,4,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	// Size is the size of the result in bytes.
+	Size int64 `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	// Created is the time the result was created, in seconds since
+	// the Unix epoch.
+	Created int64 `protobuf:"varint,6,opt,name=Created,proto3" json:"Created,omitempty"`
+	// InMAPVersion is the version of InMAP used to create the result.
+	InMAPVersion string `protobuf:"bytes,7,opt,name=InMAPVersion,proto3" json:"InMAPVersion,omitempty"`
+	// CityID is the ID of the city. It is empty for results that were
+	// created by earlier versions of CityAQ.
+	CityID               string   `protobuf:"bytes,8,opt,name=CityID,proto3" json:"CityID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImpactSummaryRequest) Reset()         { *m = ImpactSummaryRequest{} }
-func (m *ImpactSummaryRequest) String() string { return proto.CompactTextString(m) }
-func (*ImpactSummaryRequest) ProtoMessage()    {}
-func (*ImpactSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{13}
+func (m *CachedResultInfo) Reset()         { *m = CachedResultInfo{} }
+func (m *CachedResultInfo) String() string { return proto.CompactTextString(m) }
+func (*CachedResultInfo) ProtoMessage()    {}
+func (*CachedResultInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{39}
 }
-func (m *ImpactSummaryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImpactSummaryRequest.Unmarshal(m, b)
+func (m *CachedResultInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CachedResultInfo.Unmarshal(m, b)
 }
-func (m *ImpactSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImpactSummaryRequest.Marshal(b, m, deterministic)
+func (m *CachedResultInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CachedResultInfo.Marshal(b, m, deterministic)
 }
-func (dst *ImpactSummaryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImpactSummaryRequest.Merge(dst, src)
+func (dst *CachedResultInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachedResultInfo.Merge(dst, src)
 }
-func (m *ImpactSummaryRequest) XXX_Size() int {
-	return xxx_messageInfo_ImpactSummaryRequest.Size(m)
+func (m *CachedResultInfo) XXX_Size() int {
+	return xxx_messageInfo_CachedResultInfo.Size(m)
 }
-func (m *ImpactSummaryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImpactSummaryRequest.DiscardUnknown(m)
+func (m *CachedResultInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachedResultInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CachedResultInfo proto.InternalMessageInfo
+
+func (m *CachedResultInfo) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CachedResultInfo) GetCityName() string {
+	if m != nil {
+		return m.CityName
+	}
+	return ""
+}
+
+func (m *CachedResultInfo) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *CachedResultInfo) GetScenario() bool {
+	if m != nil {
+		return m.Scenario
+	}
+	return false
 }
 
-var xxx_messageInfo_ImpactSummaryRequest proto.InternalMessageInfo
+func (m *CachedResultInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
 
-func (m *ImpactSummaryRequest) GetCityName() string {
+func (m *CachedResultInfo) GetCreated() int64 {
 	if m != nil {
-		return m.CityName
+		return m.Created
 	}
-	return ""
+	return 0
 }
 
-func (m *ImpactSummaryRequest) GetSourceType() string {
+func (m *CachedResultInfo) GetInMAPVersion() string {
 	if m != nil {
-		return m.SourceType
+		return m.InMAPVersion
 	}
 	return ""
 }
 
-func (m *ImpactSummaryRequest) GetEmission() Emission {
+func (m *CachedResultInfo) GetCityID() string {
 	if m != nil {
-		return m.Emission
+		return m.CityID
 	}
-	return Emission_UNKNOWN_EMISSION
+	return ""
 }
 
-type ImpactSummaryResponse struct {
-	// Population is the total population.
-	Population float64 `protobuf:"fixed64,1,opt,name=Population,proto3" json:"Population,omitempty"`
-	// CityPopulation is the total population in the city.
-	CityPopulation float64 `protobuf:"fixed64,2,opt,name=CityPopulation,proto3" json:"CityPopulation,omitempty"`
-	// CityExposure is the population-weighted average
-	// concentration in the city.
-	CityExposure float64 `protobuf:"fixed64,3,opt,name=CityExposure,proto3" json:"CityExposure,omitempty"`
-	// TotalExposure is the overall population-weighted average
-	// concentration.
-	TotalExposure float64 `protobuf:"fixed64,4,opt,name=TotalExposure,proto3" json:"TotalExposure,omitempty"`
-	// CityIF is the intake fraction in the city.
-	CityIF float64 `protobuf:"fixed64,5,opt,name=CityIF,proto3" json:"CityIF,omitempty"`
-	// TotalIF is the total intake fraction.
-	TotalIF              float64  `protobuf:"fixed64,6,opt,name=TotalIF,proto3" json:"TotalIF,omitempty"`
+type InvalidateCachedResultsRequest struct {
+	// Results matching all of the specified fields are deleted. At least
+	// one field must be specified. CityName may be the name or the ID of
+	// the city.
+	Key                  string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	CityName             string   `protobuf:"bytes,2,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	SourceType           string   `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImpactSummaryResponse) Reset()         { *m = ImpactSummaryResponse{} }
-func (m *ImpactSummaryResponse) String() string { return proto.CompactTextString(m) }
-func (*ImpactSummaryResponse) ProtoMessage()    {}
-func (*ImpactSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{14}
+func (m *InvalidateCachedResultsRequest) Reset()         { *m = InvalidateCachedResultsRequest{} }
+func (m *InvalidateCachedResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateCachedResultsRequest) ProtoMessage()    {}
+func (*InvalidateCachedResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{40}
 }
-func (m *ImpactSummaryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImpactSummaryResponse.Unmarshal(m, b)
+func (m *InvalidateCachedResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateCachedResultsRequest.Unmarshal(m, b)
 }
-func (m *ImpactSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImpactSummaryResponse.Marshal(b, m, deterministic)
+func (m *InvalidateCachedResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateCachedResultsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ImpactSummaryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImpactSummaryResponse.Merge(dst, src)
+func (dst *InvalidateCachedResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateCachedResultsRequest.Merge(dst, src)
 }
-func (m *ImpactSummaryResponse) XXX_Size() int {
-	return xxx_messageInfo_ImpactSummaryResponse.Size(m)
+func (m *InvalidateCachedResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateCachedResultsRequest.Size(m)
 }
-func (m *ImpactSummaryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImpactSummaryResponse.DiscardUnknown(m)
+func (m *InvalidateCachedResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateCachedResultsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImpactSummaryResponse proto.InternalMessageInfo
+var xxx_messageInfo_InvalidateCachedResultsRequest proto.InternalMessageInfo
 
-func (m *ImpactSummaryResponse) GetPopulation() float64 {
+func (m *InvalidateCachedResultsRequest) GetKey() string {
 	if m != nil {
-		return m.Population
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *ImpactSummaryResponse) GetCityPopulation() float64 {
+func (m *InvalidateCachedResultsRequest) GetCityName() string {
 	if m != nil {
-		return m.CityPopulation
+		return m.CityName
 	}
-	return 0
+	return ""
 }
 
-func (m *ImpactSummaryResponse) GetCityExposure() float64 {
+func (m *InvalidateCachedResultsRequest) GetSourceType() string {
 	if m != nil {
-		return m.CityExposure
+		return m.SourceType
 	}
-	return 0
+	return ""
 }
 
-func (m *ImpactSummaryResponse) GetTotalExposure() float64 {
-	if m != nil {
-		return m.TotalExposure
-	}
-	return 0
+type InvalidateCachedResultsResponse struct {
+	// Keys holds the keys of the deleted results.
+	Keys                 []string `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImpactSummaryResponse) GetCityIF() float64 {
-	if m != nil {
-		return m.CityIF
-	}
-	return 0
+func (m *InvalidateCachedResultsResponse) Reset()         { *m = InvalidateCachedResultsResponse{} }
+func (m *InvalidateCachedResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvalidateCachedResultsResponse) ProtoMessage()    {}
+func (*InvalidateCachedResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{41}
+}
+func (m *InvalidateCachedResultsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateCachedResultsResponse.Unmarshal(m, b)
+}
+func (m *InvalidateCachedResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateCachedResultsResponse.Marshal(b, m, deterministic)
+}
+func (dst *InvalidateCachedResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateCachedResultsResponse.Merge(dst, src)
+}
+func (m *InvalidateCachedResultsResponse) XXX_Size() int {
+	return xxx_messageInfo_InvalidateCachedResultsResponse.Size(m)
+}
+func (m *InvalidateCachedResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateCachedResultsResponse.DiscardUnknown(m)
 }
 
-func (m *ImpactSummaryResponse) GetTotalIF() float64 {
+var xxx_messageInfo_InvalidateCachedResultsResponse proto.InternalMessageInfo
+
+func (m *InvalidateCachedResultsResponse) GetKeys() []string {
 	if m != nil {
-		return m.TotalIF
+		return m.Keys
 	}
-	return 0
+	return nil
 }
 
 type EmissionsGridBoundsRequest struct {
@@ -805,7 +2898,7 @@ func (m *EmissionsGridBoundsRequest) Reset()         { *m = EmissionsGridBoundsR
 func (m *EmissionsGridBoundsRequest) String() string { return proto.CompactTextString(m) }
 func (*EmissionsGridBoundsRequest) ProtoMessage()    {}
 func (*EmissionsGridBoundsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{15}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{42}
 }
 func (m *EmissionsGridBoundsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmissionsGridBoundsRequest.Unmarshal(m, b)
@@ -851,7 +2944,7 @@ func (m *EmissionsGridBoundsResponse) Reset()         { *m = EmissionsGridBounds
 func (m *EmissionsGridBoundsResponse) String() string { return proto.CompactTextString(m) }
 func (*EmissionsGridBoundsResponse) ProtoMessage()    {}
 func (*EmissionsGridBoundsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{16}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{43}
 }
 func (m *EmissionsGridBoundsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmissionsGridBoundsResponse.Unmarshal(m, b)
@@ -886,20 +2979,25 @@ func (m *EmissionsGridBoundsResponse) GetMax() *Point {
 }
 
 type MapScaleRequest struct {
-	CityName             string     `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	ImpactType           ImpactType `protobuf:"varint,2,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
-	Emission             Emission   `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
-	SourceType           string     `protobuf:"bytes,4,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	CityName   string     `protobuf:"bytes,1,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	ImpactType ImpactType `protobuf:"varint,2,opt,name=ImpactType,proto3,enum=cityaqrpc.ImpactType" json:"ImpactType,omitempty"`
+	Emission   Emission   `protobuf:"varint,3,opt,name=Emission,proto3,enum=cityaqrpc.Emission" json:"Emission,omitempty"`
+	SourceType string     `protobuf:"bytes,4,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	// Scenario optionally specifies a source that emits several
+	// pollutants at once. If it is set, the scale of concentrations is
+	// for total PM2.5 resulting from the combined emissions, as for
+	// GriddedConcentrationsRequest.Scenario. It is ignored for emissions.
+	Scenario             *EmissionScenario `protobuf:"bytes,5,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MapScaleRequest) Reset()         { *m = MapScaleRequest{} }
 func (m *MapScaleRequest) String() string { return proto.CompactTextString(m) }
 func (*MapScaleRequest) ProtoMessage()    {}
 func (*MapScaleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{17}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{44}
 }
 func (m *MapScaleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MapScaleRequest.Unmarshal(m, b)
@@ -947,6 +3045,13 @@ func (m *MapScaleRequest) GetSourceType() string {
 	return ""
 }
 
+func (m *MapScaleRequest) GetScenario() *EmissionScenario {
+	if m != nil {
+		return m.Scenario
+	}
+	return nil
+}
+
 type MapScaleResponse struct {
 	Min                  float64  `protobuf:"fixed64,1,opt,name=Min,proto3" json:"Min,omitempty"`
 	Max                  float64  `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty"`
@@ -960,7 +3065,7 @@ func (m *MapScaleResponse) Reset()         { *m = MapScaleResponse{} }
 func (m *MapScaleResponse) String() string { return proto.CompactTextString(m) }
 func (*MapScaleResponse) ProtoMessage()    {}
 func (*MapScaleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cityaq_f793410a600d19a7, []int{18}
+	return fileDescriptor_cityaq_cd26a597c1560f9a, []int{45}
 }
 func (m *MapScaleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MapScaleResponse.Unmarshal(m, b)
@@ -1004,6 +3109,16 @@ func (m *MapScaleResponse) GetCutPt() float64 {
 func init() {
 	proto.RegisterType((*CitiesRequest)(nil), "cityaqrpc.CitiesRequest")
 	proto.RegisterType((*CitiesResponse)(nil), "cityaqrpc.CitiesResponse")
+	proto.RegisterType((*City)(nil), "cityaqrpc.City")
+	proto.RegisterMapType((map[string]string)(nil), "cityaqrpc.City.DisplayNamesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cityaqrpc.City.PropertiesEntry")
+	proto.RegisterType((*BoundingBox)(nil), "cityaqrpc.BoundingBox")
+	proto.RegisterType((*OptionsRequest)(nil), "cityaqrpc.OptionsRequest")
+	proto.RegisterType((*OptionsResponse)(nil), "cityaqrpc.OptionsResponse")
+	proto.RegisterType((*SourceTypeInfo)(nil), "cityaqrpc.SourceTypeInfo")
+	proto.RegisterType((*OSMTag)(nil), "cityaqrpc.OSMTag")
+	proto.RegisterType((*EmissionInfo)(nil), "cityaqrpc.EmissionInfo")
+	proto.RegisterType((*ImpactTypeInfo)(nil), "cityaqrpc.ImpactTypeInfo")
 	proto.RegisterType((*CityGeometryRequest)(nil), "cityaqrpc.CityGeometryRequest")
 	proto.RegisterType((*CityGeometryResponse)(nil), "cityaqrpc.CityGeometryResponse")
 	proto.RegisterType((*Polygon)(nil), "cityaqrpc.Polygon")
@@ -1013,126 +3128,399 @@ func init() {
 	proto.RegisterType((*GriddedEmissionsResponse)(nil), "cityaqrpc.GriddedEmissionsResponse")
 	proto.RegisterType((*GriddedConcentrationsRequest)(nil), "cityaqrpc.GriddedConcentrationsRequest")
 	proto.RegisterType((*GriddedConcentrationsResponse)(nil), "cityaqrpc.GriddedConcentrationsResponse")
+	proto.RegisterType((*EmissionScenario)(nil), "cityaqrpc.EmissionScenario")
+	proto.RegisterType((*PollutantEmission)(nil), "cityaqrpc.PollutantEmission")
 	proto.RegisterType((*GriddedPopulationRequest)(nil), "cityaqrpc.GriddedPopulationRequest")
 	proto.RegisterType((*GriddedPopulationResponse)(nil), "cityaqrpc.GriddedPopulationResponse")
+	proto.RegisterType((*CompactGrid)(nil), "cityaqrpc.CompactGrid")
 	proto.RegisterType((*ImpactSummaryRequest)(nil), "cityaqrpc.ImpactSummaryRequest")
 	proto.RegisterType((*ImpactSummaryResponse)(nil), "cityaqrpc.ImpactSummaryResponse")
+	proto.RegisterType((*DamagesRequest)(nil), "cityaqrpc.DamagesRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "cityaqrpc.DamagesRequest.CountryIncomesEntry")
+	proto.RegisterType((*DamagesResponse)(nil), "cityaqrpc.DamagesResponse")
+	proto.RegisterType((*JobProgressRequest)(nil), "cityaqrpc.JobProgressRequest")
+	proto.RegisterType((*JobProgressResponse)(nil), "cityaqrpc.JobProgressResponse")
+	proto.RegisterType((*SubmitConcentrationJobRequest)(nil), "cityaqrpc.SubmitConcentrationJobRequest")
+	proto.RegisterType((*Job)(nil), "cityaqrpc.Job")
+	proto.RegisterType((*GetJobRequest)(nil), "cityaqrpc.GetJobRequest")
+	proto.RegisterType((*CancelJobRequest)(nil), "cityaqrpc.CancelJobRequest")
+	proto.RegisterType((*ListJobsRequest)(nil), "cityaqrpc.ListJobsRequest")
+	proto.RegisterType((*ListJobsResponse)(nil), "cityaqrpc.ListJobsResponse")
+	proto.RegisterType((*ListCachedResultsRequest)(nil), "cityaqrpc.ListCachedResultsRequest")
+	proto.RegisterType((*ListCachedResultsResponse)(nil), "cityaqrpc.ListCachedResultsResponse")
+	proto.RegisterType((*CachedResultRequest)(nil), "cityaqrpc.CachedResultRequest")
+	proto.RegisterType((*CachedResultInfo)(nil), "cityaqrpc.CachedResultInfo")
+	proto.RegisterType((*InvalidateCachedResultsRequest)(nil), "cityaqrpc.InvalidateCachedResultsRequest")
+	proto.RegisterType((*InvalidateCachedResultsResponse)(nil), "cityaqrpc.InvalidateCachedResultsResponse")
 	proto.RegisterType((*EmissionsGridBoundsRequest)(nil), "cityaqrpc.EmissionsGridBoundsRequest")
 	proto.RegisterType((*EmissionsGridBoundsResponse)(nil), "cityaqrpc.EmissionsGridBoundsResponse")
 	proto.RegisterType((*MapScaleRequest)(nil), "cityaqrpc.MapScaleRequest")
 	proto.RegisterType((*MapScaleResponse)(nil), "cityaqrpc.MapScaleResponse")
+	proto.RegisterEnum("cityaqrpc.JobState", JobState_name, JobState_value)
 	proto.RegisterEnum("cityaqrpc.Emission", Emission_name, Emission_value)
+	proto.RegisterEnum("cityaqrpc.GridEncoding", GridEncoding_name, GridEncoding_value)
 	proto.RegisterEnum("cityaqrpc.ImpactType", ImpactType_name, ImpactType_value)
+	proto.RegisterEnum("cityaqrpc.ConcentrationResponse", ConcentrationResponse_name, ConcentrationResponse_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CityAQClient is the client API for CityAQ service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CityAQClient interface {
+	// Cities returns the available cities.
+	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error)
+	// Options returns the source types, pollutants and impact types
+	// that can be requested, as configured on the server.
+	Options(ctx context.Context, in *OptionsRequest, opts ...grpc.CallOption) (*OptionsResponse, error)
+	// CityGeometry returns the boundary of the specified city.
+	CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error)
+	// GriddedEmissions returns the distribution within the city of
+	// the requested amount of emissions (by default 1 kilotonne).
+	GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error)
+	// StreamGriddedEmissions returns the same information as
+	// GriddedEmissions, split into messages that each hold a subset of
+	// the grid cells, in order. When compact encoding is requested, a
+	// regular grid is only described by the first message, and otherwise
+	// each message holds the CellBounds of its own cells.
+	StreamGriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedEmissionsClient, error)
+	// EmissionsGridBounds returns the bounds of the grid used in GriddedEmissions.
+	EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error)
+	// GriddedConcentrations returns the concentrations resulting from the
+	// corresponding GriddedEmissions.
+	GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error)
+	// StreamGriddedConcentrations is the streaming version of
+	// GriddedConcentrations, in the same way as StreamGriddedEmissions.
+	StreamGriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedConcentrationsClient, error)
+	// MapScale returns the minimum and maximum values of the result
+	// of the given request.
+	MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error)
+	// GriddedPopulation returns the population counts---gridded to the
+	// concentration grid---associated with the given request.
+	GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error)
+	// StreamGriddedPopulation is the streaming version of
+	// GriddedPopulation, in the same way as StreamGriddedEmissions.
+	StreamGriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedPopulationClient, error)
+	// ImpactSummary returns a summary of the impacts from the given request.
+	ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error)
+	// Damages returns the monetized health damages per tonne of
+	// emissions from the given request.
+	Damages(ctx context.Context, in *DamagesRequest, opts ...grpc.CallOption) (*DamagesResponse, error)
+	// JobProgress reports the state of the concentration model job
+	// associated with the given request each time it changes, until
+	// the results are cached or the job fails.
+	JobProgress(ctx context.Context, in *JobProgressRequest, opts ...grpc.CallOption) (CityAQ_JobProgressClient, error)
+	// SubmitConcentrationJob starts calculating the concentrations
+	// associated with the given request and returns without waiting
+	// for the calculation to finish.
+	SubmitConcentrationJob(ctx context.Context, in *SubmitConcentrationJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// GetJob returns the current state of the specified job.
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// CancelJob stops the specified job.
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// ListJobs returns all known concentration jobs.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// ListCachedResults returns information about the cached concentration
+	// results that match the request. It requires administrator access.
+	ListCachedResults(ctx context.Context, in *ListCachedResultsRequest, opts ...grpc.CallOption) (*ListCachedResultsResponse, error)
+	// CachedResult returns information about the cached concentration
+	// result with the given key. It requires administrator access.
+	CachedResult(ctx context.Context, in *CachedResultRequest, opts ...grpc.CallOption) (*CachedResultInfo, error)
+	// InvalidateCachedResults deletes the cached concentration results
+	// that match the request. It requires administrator access.
+	InvalidateCachedResults(ctx context.Context, in *InvalidateCachedResultsRequest, opts ...grpc.CallOption) (*InvalidateCachedResultsResponse, error)
+}
+
+type cityAQClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCityAQClient(cc *grpc.ClientConn) CityAQClient {
+	return &cityAQClient{cc}
+}
+
+func (c *cityAQClient) Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error) {
+	out := new(CitiesResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Cities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) Options(ctx context.Context, in *OptionsRequest, opts ...grpc.CallOption) (*OptionsResponse, error) {
+	out := new(OptionsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Options", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error) {
+	out := new(CityGeometryResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CityGeometry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error) {
+	out := new(GriddedEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedEmissionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[0], "/cityaqrpc.CityAQ/StreamGriddedEmissions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedEmissionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_StreamGriddedEmissionsClient interface {
+	Recv() (*GriddedEmissionsResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQStreamGriddedEmissionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQStreamGriddedEmissionsClient) Recv() (*GriddedEmissionsResponse, error) {
+	m := new(GriddedEmissionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error) {
+	out := new(EmissionsGridBoundsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/EmissionsGridBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error) {
+	out := new(GriddedConcentrationsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedConcentrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedConcentrationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[1], "/cityaqrpc.CityAQ/StreamGriddedConcentrations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedConcentrationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_StreamGriddedConcentrationsClient interface {
+	Recv() (*GriddedConcentrationsResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQStreamGriddedConcentrationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQStreamGriddedConcentrationsClient) Recv() (*GriddedConcentrationsResponse, error) {
+	m := new(GriddedConcentrationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error) {
+	out := new(MapScaleResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/MapScale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error) {
+	out := new(GriddedPopulationResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedPopulation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityAQClient) StreamGriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (CityAQ_StreamGriddedPopulationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[2], "/cityaqrpc.CityAQ/StreamGriddedPopulation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQStreamGriddedPopulationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CityAQ_StreamGriddedPopulationClient interface {
+	Recv() (*GriddedPopulationResponse, error)
+	grpc.ClientStream
+}
+
+type cityAQStreamGriddedPopulationClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAQStreamGriddedPopulationClient) Recv() (*GriddedPopulationResponse, error) {
+	m := new(GriddedPopulationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error) {
+	out := new(ImpactSummaryResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ImpactSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *cityAQClient) Damages(ctx context.Context, in *DamagesRequest, opts ...grpc.CallOption) (*DamagesResponse, error) {
+	out := new(DamagesResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Damages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// CityAQClient is the client API for CityAQ service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CityAQClient interface {
-	// Cities returns the available cities.
-	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error)
-	// CityGeometry returns the boundary of the specified city.
-	CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error)
-	// GriddedEmissions returns the distribution within the city of
-	// 1 kilotonne of emissions.
-	GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error)
-	// EmissionsGridBounds returns the bounds of the grid used in GriddedEmissions.
-	EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error)
-	// GriddedConcentrations returns the concentrations resulting from the
-	// corresponding GriddedEmissions.
-	GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error)
-	// MapScale returns the minimum and maximum values of the result
-	// of the given request.
-	MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error)
-	// GriddedPopulation returns the population counts---gridded to the
-	// concentration grid---associated with the given request.
-	GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error)
-	// ImpactSummary returns a summary of the impacts from the given request.
-	ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error)
+func (c *cityAQClient) JobProgress(ctx context.Context, in *JobProgressRequest, opts ...grpc.CallOption) (CityAQ_JobProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CityAQ_serviceDesc.Streams[3], "/cityaqrpc.CityAQ/JobProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAQJobProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type cityAQClient struct {
-	cc *grpc.ClientConn
+type CityAQ_JobProgressClient interface {
+	Recv() (*JobProgressResponse, error)
+	grpc.ClientStream
 }
 
-func NewCityAQClient(cc *grpc.ClientConn) CityAQClient {
-	return &cityAQClient{cc}
+type cityAQJobProgressClient struct {
+	grpc.ClientStream
 }
 
-func (c *cityAQClient) Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*CitiesResponse, error) {
-	out := new(CitiesResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/Cities", in, out, opts...)
-	if err != nil {
+func (x *cityAQJobProgressClient) Recv() (*JobProgressResponse, error) {
+	m := new(JobProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *cityAQClient) CityGeometry(ctx context.Context, in *CityGeometryRequest, opts ...grpc.CallOption) (*CityGeometryResponse, error) {
-	out := new(CityGeometryResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CityGeometry", in, out, opts...)
+func (c *cityAQClient) SubmitConcentrationJob(ctx context.Context, in *SubmitConcentrationJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/SubmitConcentrationJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedEmissions(ctx context.Context, in *GriddedEmissionsRequest, opts ...grpc.CallOption) (*GriddedEmissionsResponse, error) {
-	out := new(GriddedEmissionsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedEmissions", in, out, opts...)
+func (c *cityAQClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GetJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) EmissionsGridBounds(ctx context.Context, in *EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*EmissionsGridBoundsResponse, error) {
-	out := new(EmissionsGridBoundsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/EmissionsGridBounds", in, out, opts...)
+func (c *cityAQClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CancelJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedConcentrations(ctx context.Context, in *GriddedConcentrationsRequest, opts ...grpc.CallOption) (*GriddedConcentrationsResponse, error) {
-	out := new(GriddedConcentrationsResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedConcentrations", in, out, opts...)
+func (c *cityAQClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ListJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) MapScale(ctx context.Context, in *MapScaleRequest, opts ...grpc.CallOption) (*MapScaleResponse, error) {
-	out := new(MapScaleResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/MapScale", in, out, opts...)
+func (c *cityAQClient) ListCachedResults(ctx context.Context, in *ListCachedResultsRequest, opts ...grpc.CallOption) (*ListCachedResultsResponse, error) {
+	out := new(ListCachedResultsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ListCachedResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) GriddedPopulation(ctx context.Context, in *GriddedPopulationRequest, opts ...grpc.CallOption) (*GriddedPopulationResponse, error) {
-	out := new(GriddedPopulationResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/GriddedPopulation", in, out, opts...)
+func (c *cityAQClient) CachedResult(ctx context.Context, in *CachedResultRequest, opts ...grpc.CallOption) (*CachedResultInfo, error) {
+	out := new(CachedResultInfo)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/CachedResult", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryRequest, opts ...grpc.CallOption) (*ImpactSummaryResponse, error) {
-	out := new(ImpactSummaryResponse)
-	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/ImpactSummary", in, out, opts...)
+func (c *cityAQClient) InvalidateCachedResults(ctx context.Context, in *InvalidateCachedResultsRequest, opts ...grpc.CallOption) (*InvalidateCachedResultsResponse, error) {
+	out := new(InvalidateCachedResultsResponse)
+	err := c.cc.Invoke(ctx, "/cityaqrpc.CityAQ/InvalidateCachedResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1143,24 +3531,65 @@ func (c *cityAQClient) ImpactSummary(ctx context.Context, in *ImpactSummaryReque
 type CityAQServer interface {
 	// Cities returns the available cities.
 	Cities(context.Context, *CitiesRequest) (*CitiesResponse, error)
+	// Options returns the source types, pollutants and impact types
+	// that can be requested, as configured on the server.
+	Options(context.Context, *OptionsRequest) (*OptionsResponse, error)
 	// CityGeometry returns the boundary of the specified city.
 	CityGeometry(context.Context, *CityGeometryRequest) (*CityGeometryResponse, error)
 	// GriddedEmissions returns the distribution within the city of
-	// 1 kilotonne of emissions.
+	// the requested amount of emissions (by default 1 kilotonne).
 	GriddedEmissions(context.Context, *GriddedEmissionsRequest) (*GriddedEmissionsResponse, error)
+	// StreamGriddedEmissions returns the same information as
+	// GriddedEmissions, split into messages that each hold a subset of
+	// the grid cells, in order. When compact encoding is requested, a
+	// regular grid is only described by the first message, and otherwise
+	// each message holds the CellBounds of its own cells.
+	StreamGriddedEmissions(*GriddedEmissionsRequest, CityAQ_StreamGriddedEmissionsServer) error
 	// EmissionsGridBounds returns the bounds of the grid used in GriddedEmissions.
 	EmissionsGridBounds(context.Context, *EmissionsGridBoundsRequest) (*EmissionsGridBoundsResponse, error)
 	// GriddedConcentrations returns the concentrations resulting from the
 	// corresponding GriddedEmissions.
 	GriddedConcentrations(context.Context, *GriddedConcentrationsRequest) (*GriddedConcentrationsResponse, error)
+	// StreamGriddedConcentrations is the streaming version of
+	// GriddedConcentrations, in the same way as StreamGriddedEmissions.
+	StreamGriddedConcentrations(*GriddedConcentrationsRequest, CityAQ_StreamGriddedConcentrationsServer) error
 	// MapScale returns the minimum and maximum values of the result
 	// of the given request.
 	MapScale(context.Context, *MapScaleRequest) (*MapScaleResponse, error)
 	// GriddedPopulation returns the population counts---gridded to the
 	// concentration grid---associated with the given request.
 	GriddedPopulation(context.Context, *GriddedPopulationRequest) (*GriddedPopulationResponse, error)
+	// StreamGriddedPopulation is the streaming version of
+	// GriddedPopulation, in the same way as StreamGriddedEmissions.
+	StreamGriddedPopulation(*GriddedPopulationRequest, CityAQ_StreamGriddedPopulationServer) error
 	// ImpactSummary returns a summary of the impacts from the given request.
 	ImpactSummary(context.Context, *ImpactSummaryRequest) (*ImpactSummaryResponse, error)
+	// Damages returns the monetized health damages per tonne of
+	// emissions from the given request.
+	Damages(context.Context, *DamagesRequest) (*DamagesResponse, error)
+	// JobProgress reports the state of the concentration model job
+	// associated with the given request each time it changes, until
+	// the results are cached or the job fails.
+	JobProgress(*JobProgressRequest, CityAQ_JobProgressServer) error
+	// SubmitConcentrationJob starts calculating the concentrations
+	// associated with the given request and returns without waiting
+	// for the calculation to finish.
+	SubmitConcentrationJob(context.Context, *SubmitConcentrationJobRequest) (*Job, error)
+	// GetJob returns the current state of the specified job.
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+	// CancelJob stops the specified job.
+	CancelJob(context.Context, *CancelJobRequest) (*Job, error)
+	// ListJobs returns all known concentration jobs.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// ListCachedResults returns information about the cached concentration
+	// results that match the request. It requires administrator access.
+	ListCachedResults(context.Context, *ListCachedResultsRequest) (*ListCachedResultsResponse, error)
+	// CachedResult returns information about the cached concentration
+	// result with the given key. It requires administrator access.
+	CachedResult(context.Context, *CachedResultRequest) (*CachedResultInfo, error)
+	// InvalidateCachedResults deletes the cached concentration results
+	// that match the request. It requires administrator access.
+	InvalidateCachedResults(context.Context, *InvalidateCachedResultsRequest) (*InvalidateCachedResultsResponse, error)
 }
 
 func RegisterCityAQServer(s *grpc.Server, srv CityAQServer) {
@@ -1185,6 +3614,24 @@ func _CityAQ_Cities_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_Options_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).Options(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/Options",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).Options(ctx, req.(*OptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CityAQ_CityGeometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CityGeometryRequest)
 	if err := dec(in); err != nil {
@@ -1221,6 +3668,27 @@ func _CityAQ_GriddedEmissions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedEmissions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedEmissionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedEmissions(m, &cityAQStreamGriddedEmissionsServer{stream})
+}
+
+type CityAQ_StreamGriddedEmissionsServer interface {
+	Send(*GriddedEmissionsResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedEmissionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedEmissionsServer) Send(m *GriddedEmissionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_EmissionsGridBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmissionsGridBoundsRequest)
 	if err := dec(in); err != nil {
@@ -1257,6 +3725,27 @@ func _CityAQ_GriddedConcentrations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedConcentrations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedConcentrationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedConcentrations(m, &cityAQStreamGriddedConcentrationsServer{stream})
+}
+
+type CityAQ_StreamGriddedConcentrationsServer interface {
+	Send(*GriddedConcentrationsResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedConcentrationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedConcentrationsServer) Send(m *GriddedConcentrationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_MapScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MapScaleRequest)
 	if err := dec(in); err != nil {
@@ -1293,6 +3782,27 @@ func _CityAQ_GriddedPopulation_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_StreamGriddedPopulation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GriddedPopulationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).StreamGriddedPopulation(m, &cityAQStreamGriddedPopulationServer{stream})
+}
+
+type CityAQ_StreamGriddedPopulationServer interface {
+	Send(*GriddedPopulationResponse) error
+	grpc.ServerStream
+}
+
+type cityAQStreamGriddedPopulationServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQStreamGriddedPopulationServer) Send(m *GriddedPopulationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CityAQ_ImpactSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImpactSummaryRequest)
 	if err := dec(in); err != nil {
@@ -1311,6 +3821,171 @@ func _CityAQ_ImpactSummary_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CityAQ_Damages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DamagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).Damages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/Damages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).Damages(ctx, req.(*DamagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_JobProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityAQServer).JobProgress(m, &cityAQJobProgressServer{stream})
+}
+
+type CityAQ_JobProgressServer interface {
+	Send(*JobProgressResponse) error
+	grpc.ServerStream
+}
+
+type cityAQJobProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAQJobProgressServer) Send(m *JobProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CityAQ_SubmitConcentrationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitConcentrationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).SubmitConcentrationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/SubmitConcentrationJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).SubmitConcentrationJob(ctx, req.(*SubmitConcentrationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_ListCachedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCachedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).ListCachedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/ListCachedResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).ListCachedResults(ctx, req.(*ListCachedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_CachedResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CachedResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).CachedResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/CachedResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).CachedResult(ctx, req.(*CachedResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityAQ_InvalidateCachedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCachedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityAQServer).InvalidateCachedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cityaqrpc.CityAQ/InvalidateCachedResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityAQServer).InvalidateCachedResults(ctx, req.(*InvalidateCachedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CityAQ_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cityaqrpc.CityAQ",
 	HandlerType: (*CityAQServer)(nil),
@@ -1319,6 +3994,10 @@ var _CityAQ_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Cities",
 			Handler:    _CityAQ_Cities_Handler,
 		},
+		{
+			MethodName: "Options",
+			Handler:    _CityAQ_Options_Handler,
+		},
 		{
 			MethodName: "CityGeometry",
 			Handler:    _CityAQ_CityGeometry_Handler,
@@ -1347,66 +4026,227 @@ var _CityAQ_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImpactSummary",
 			Handler:    _CityAQ_ImpactSummary_Handler,
 		},
+		{
+			MethodName: "Damages",
+			Handler:    _CityAQ_Damages_Handler,
+		},
+		{
+			MethodName: "SubmitConcentrationJob",
+			Handler:    _CityAQ_SubmitConcentrationJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _CityAQ_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _CityAQ_CancelJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _CityAQ_ListJobs_Handler,
+		},
+		{
+			MethodName: "ListCachedResults",
+			Handler:    _CityAQ_ListCachedResults_Handler,
+		},
+		{
+			MethodName: "CachedResult",
+			Handler:    _CityAQ_CachedResult_Handler,
+		},
+		{
+			MethodName: "InvalidateCachedResults",
+			Handler:    _CityAQ_InvalidateCachedResults_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGriddedEmissions",
+			Handler:       _CityAQ_StreamGriddedEmissions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGriddedConcentrations",
+			Handler:       _CityAQ_StreamGriddedConcentrations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGriddedPopulation",
+			Handler:       _CityAQ_StreamGriddedPopulation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JobProgress",
+			Handler:       _CityAQ_JobProgress_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cityaq.proto",
 }
 
-func init() { proto.RegisterFile("cityaq.proto", fileDescriptor_cityaq_f793410a600d19a7) }
-
-var fileDescriptor_cityaq_f793410a600d19a7 = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdb, 0x4e, 0xf3, 0x46,
-	0x10, 0x66, 0x73, 0x22, 0x99, 0x9f, 0x80, 0x3b, 0x84, 0xbf, 0xfe, 0x0d, 0x85, 0x68, 0x39, 0x34,
-	0xe2, 0x22, 0xa5, 0x41, 0x5c, 0x57, 0x10, 0x05, 0x1a, 0xb5, 0x49, 0x8c, 0x93, 0xb6, 0x50, 0xa9,
-	0xa2, 0x6e, 0xe2, 0x16, 0xab, 0x89, 0x6d, 0xe2, 0x8d, 0x9a, 0x5c, 0x57, 0x6a, 0x2f, 0xfa, 0x3c,
-	0x7d, 0x8f, 0xde, 0xf7, 0x65, 0x2a, 0xaf, 0xcf, 0x89, 0x03, 0x14, 0x21, 0x71, 0xb7, 0x33, 0xf3,
-	0xcd, 0xe9, 0xf3, 0xce, 0x8e, 0x61, 0xad, 0xaf, 0xb3, 0x99, 0xfa, 0x50, 0xb5, 0xc6, 0x26, 0x33,
-	0xb1, 0xe0, 0x4a, 0x63, 0xab, 0x4f, 0x37, 0xa0, 0x58, 0xd7, 0x99, 0xae, 0xd9, 0x8a, 0xf6, 0x30,
-	0xd1, 0x6c, 0x46, 0x8f, 0x60, 0xdd, 0x57, 0xd8, 0x96, 0x69, 0xd8, 0x1a, 0x96, 0x20, 0xdb, 0x56,
-	0x47, 0x9a, 0x2d, 0x92, 0x72, 0xba, 0x52, 0x50, 0x5c, 0x81, 0x7e, 0x0e, 0x9b, 0x75, 0x9d, 0xcd,
-	0xae, 0x34, 0x73, 0xa4, 0xb1, 0xf1, 0xcc, 0x73, 0x47, 0x09, 0xf2, 0x8e, 0xda, 0xc1, 0x88, 0xa4,
-	0x4c, 0x2a, 0x05, 0x25, 0x90, 0xe9, 0x25, 0x94, 0xe2, 0x2e, 0x5e, 0x82, 0x2a, 0xe4, 0x65, 0x73,
-	0x38, 0xfb, 0xc5, 0x34, 0xdc, 0x1c, 0xef, 0x6a, 0x58, 0x0d, 0x2a, 0xac, 0x7a, 0x26, 0x25, 0xc0,
-	0xd0, 0x13, 0x58, 0xf5, 0xce, 0x78, 0x08, 0x59, 0x59, 0x65, 0xf7, 0xbe, 0xdf, 0x46, 0xd4, 0x4f,
-	0x65, 0xf7, 0x8a, 0x6b, 0xa5, 0x27, 0x90, 0x71, 0x0e, 0x58, 0x81, 0x9c, 0x6c, 0xea, 0x06, 0xf3,
-	0xf1, 0x42, 0x2c, 0x8f, 0x6e, 0x30, 0xc5, 0xb3, 0xd3, 0x7d, 0xc8, 0xf2, 0x13, 0xae, 0x01, 0xb9,
-	0xe1, 0x9d, 0x10, 0x85, 0xdc, 0x38, 0xd2, 0xad, 0x98, 0x72, 0xa5, 0x5b, 0xfa, 0x07, 0x81, 0x8f,
-	0xaf, 0xc6, 0xfa, 0x60, 0xa0, 0x0d, 0x1a, 0x23, 0xdd, 0xb6, 0x75, 0xd3, 0xb0, 0x9f, 0x41, 0x04,
-	0xee, 0x02, 0x74, 0xcd, 0xc9, 0xb8, 0xaf, 0xf5, 0x66, 0x96, 0xc6, 0xc3, 0x15, 0x94, 0x88, 0x06,
-	0x3f, 0x83, 0xbc, 0x1f, 0x4f, 0x4c, 0x97, 0x49, 0x65, 0xbd, 0xb6, 0x19, 0x29, 0xd4, 0x37, 0x29,
-	0x01, 0x88, 0xde, 0x83, 0xb8, 0x58, 0xc7, 0xcb, 0xd8, 0xc5, 0x1d, 0x28, 0x04, 0x41, 0xc4, 0x54,
-	0x39, 0x5d, 0x21, 0x4a, 0xa8, 0xa0, 0x7f, 0x11, 0xd8, 0xf1, 0x52, 0xd5, 0x4d, 0xa3, 0xaf, 0x19,
-	0x6c, 0xac, 0xb2, 0x37, 0xeb, 0xfb, 0x37, 0xf8, 0x64, 0x49, 0x31, 0x2f, 0x6c, 0xde, 0xb9, 0xfd,
-	0xb1, 0x48, 0x1e, 0x03, 0x73, 0x5a, 0xfa, 0x27, 0x09, 0x18, 0x97, 0x4d, 0x6b, 0x32, 0xe4, 0xea,
-	0x37, 0xa1, 0xe0, 0x57, 0xf8, 0x90, 0x50, 0xc8, 0x0b, 0xdb, 0xdf, 0x05, 0x08, 0xa3, 0x78, 0xad,
-	0x47, 0x34, 0xf4, 0x77, 0x02, 0xa5, 0xe6, 0xc8, 0x52, 0xfb, 0xac, 0x3b, 0x19, 0x8d, 0xd4, 0x67,
-	0x8d, 0xfd, 0xeb, 0xb7, 0xfc, 0x2f, 0x81, 0xad, 0xb9, 0x2a, 0xbc, 0x7e, 0xe3, 0xf5, 0xbb, 0x53,
-	0x1b, 0xd1, 0xa0, 0xfb, 0xb8, 0xcd, 0x62, 0x3d, 0x12, 0xfe, 0x79, 0x63, 0x5a, 0xa4, 0xb0, 0xe6,
-	0x68, 0x1a, 0x53, 0xcb, 0xb4, 0x27, 0x63, 0x8d, 0x97, 0x45, 0x94, 0x98, 0x0e, 0x0f, 0xa0, 0xd8,
-	0x33, 0x99, 0x3a, 0x0c, 0x40, 0x19, 0x0e, 0x8a, 0x2b, 0xf1, 0x3d, 0xe4, 0x1c, 0xaf, 0xe6, 0xa5,
-	0x98, 0xe5, 0x66, 0x4f, 0x42, 0x11, 0x56, 0x39, 0xb0, 0x79, 0x29, 0xe6, 0xb8, 0xc1, 0x17, 0xe9,
-	0x0d, 0x48, 0xc1, 0xb8, 0x39, 0x5f, 0xf6, 0xc2, 0x9c, 0x18, 0x83, 0xd7, 0x18, 0x2f, 0xaa, 0xc1,
-	0x76, 0x62, 0x64, 0x8f, 0x3c, 0x0a, 0xe9, 0x96, 0xee, 0xb2, 0x96, 0xf4, 0x32, 0x3a, 0x46, 0x8e,
-	0x51, 0xa7, 0x3c, 0x76, 0x32, 0x46, 0x9d, 0xd2, 0xbf, 0x09, 0x6c, 0xb4, 0x54, 0xab, 0xdb, 0x57,
-	0x87, 0xda, 0x73, 0xca, 0x3e, 0x03, 0x70, 0xbf, 0x66, 0x50, 0xf6, 0x7a, 0x6d, 0x2b, 0x12, 0x3a,
-	0x34, 0x2a, 0x11, 0xe0, 0xff, 0xbe, 0x36, 0x73, 0xf4, 0x64, 0x16, 0xe8, 0xf9, 0x1a, 0x84, 0xb0,
-	0x6c, 0x8f, 0x13, 0x21, 0xe4, 0x84, 0xb8, 0x0c, 0x08, 0x21, 0x03, 0x84, 0xf7, 0xeb, 0xec, 0xc7,
-	0xfa, 0x84, 0xc9, 0xcc, 0xbb, 0x25, 0xae, 0x70, 0xdc, 0x09, 0xcb, 0xc3, 0x12, 0x08, 0xdf, 0xb4,
-	0xbf, 0x6a, 0x77, 0xbe, 0x6b, 0xdf, 0x35, 0x5a, 0xcd, 0x6e, 0xb7, 0xd9, 0x69, 0x0b, 0x2b, 0x58,
-	0x80, 0xac, 0xdc, 0xaa, 0xdd, 0x9d, 0x09, 0x04, 0x57, 0x21, 0xdd, 0xfe, 0xf2, 0x54, 0x48, 0xf1,
-	0x43, 0x67, 0x2a, 0xa4, 0x9d, 0x43, 0xb7, 0x33, 0x15, 0x32, 0xce, 0xe1, 0xdb, 0x4e, 0x5d, 0xc8,
-	0x1e, 0x5f, 0x45, 0x69, 0xc2, 0xf7, 0x80, 0x7e, 0xc8, 0x66, 0x4b, 0x3e, 0xaf, 0xf7, 0x7a, 0xb7,
-	0x72, 0x43, 0x58, 0xc1, 0x62, 0xe4, 0xf5, 0x16, 0x08, 0xe2, 0xfc, 0x7b, 0x26, 0xa4, 0x6a, 0xff,
-	0x64, 0xdd, 0x3b, 0x79, 0x7e, 0x8d, 0x5f, 0xf0, 0x93, 0xae, 0xd9, 0x28, 0x46, 0xb8, 0x8b, 0xfd,
-	0x10, 0x48, 0x1f, 0x12, 0x2c, 0x2e, 0x3b, 0x74, 0x05, 0xaf, 0xdd, 0x41, 0xf1, 0x57, 0x3a, 0xee,
-	0xc6, 0xc1, 0xf3, 0xbf, 0x07, 0xd2, 0xde, 0x52, 0x7b, 0x10, 0xf2, 0x07, 0x10, 0xe6, 0x77, 0x19,
-	0xd2, 0x88, 0xdb, 0x92, 0x85, 0x2b, 0xed, 0x3f, 0x8a, 0x09, 0xc2, 0xff, 0x0c, 0x9b, 0x09, 0x43,
-	0x80, 0x87, 0x09, 0x77, 0x67, 0x71, 0xfc, 0xa4, 0xa3, 0xa7, 0x60, 0x41, 0x9e, 0x21, 0x6c, 0x25,
-	0xae, 0x26, 0xfc, 0x74, 0xb1, 0xce, 0xc4, 0x4d, 0x2a, 0x55, 0x9e, 0x06, 0x06, 0xd9, 0x1a, 0x90,
-	0xf7, 0xef, 0x2e, 0x4a, 0x11, 0xbf, 0xb9, 0x39, 0x94, 0xb6, 0x13, 0x6d, 0x41, 0x98, 0x1f, 0xe1,
-	0xa3, 0x85, 0x65, 0x82, 0x09, 0xc4, 0x2e, 0xec, 0x3c, 0xe9, 0xe0, 0x71, 0x50, 0x90, 0xa1, 0x07,
-	0xc5, 0xd8, 0xd3, 0x8d, 0x7b, 0x0b, 0x93, 0x1e, 0x5f, 0x2d, 0x52, 0x79, 0x39, 0xc0, 0x8f, 0x7a,
-	0xf1, 0xee, 0xfb, 0xf0, 0x97, 0xf6, 0xa7, 0x1c, 0xff, 0xc9, 0x3d, 0xfd, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0x4a, 0xb3, 0xf9, 0x83, 0xf4, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("cityaq.proto", fileDescriptor_cityaq_cd26a597c1560f9a) }
+
+var fileDescriptor_cityaq_cd26a597c1560f9a = []byte{
+	// 2562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xec, 0x92, 0x14, 0xf9, 0x28, 0x51, 0xab, 0x91, 0x2d, 0xd1, 0xeb, 0xd8, 0x56, 0x36,
+	0x89, 0xad, 0x28, 0xa9, 0xaa, 0x4a, 0x56, 0xeb, 0xba, 0x28, 0x0c, 0x89, 0xa4, 0x1d, 0xca, 0xe6,
+	0x1f, 0x2f, 0x25, 0xd5, 0x0e, 0x50, 0x24, 0x2b, 0x72, 0x2c, 0x2f, 0x42, 0xee, 0x32, 0xcb, 0x65,
+	0x22, 0xf6, 0xda, 0x43, 0x4e, 0xbd, 0x15, 0x05, 0x7a, 0xe8, 0xa1, 0x87, 0xde, 0x0a, 0xb4, 0xe7,
+	0xa2, 0xe8, 0xa1, 0x9f, 0xa0, 0xdf, 0xa1, 0x40, 0x8f, 0xfd, 0x02, 0x05, 0x8a, 0x62, 0x66, 0x67,
+	0x77, 0x67, 0xc8, 0xa5, 0x2c, 0x39, 0x72, 0x8d, 0xf6, 0x36, 0xf3, 0xde, 0x6f, 0xe7, 0xcf, 0x9b,
+	0xdf, 0x7b, 0x6f, 0xe6, 0x91, 0x30, 0xd7, 0xb6, 0xfd, 0x91, 0xf5, 0xe5, 0x46, 0xdf, 0x73, 0x7d,
+	0x17, 0xe7, 0x82, 0x9e, 0xd7, 0x6f, 0x1b, 0x1f, 0xc1, 0x7c, 0xc9, 0xf6, 0x6d, 0x32, 0x30, 0xc9,
+	0x97, 0x43, 0x32, 0xf0, 0xb1, 0x0e, 0xd9, 0x27, 0x96, 0x73, 0x32, 0xb4, 0x4e, 0x48, 0x11, 0xad,
+	0xa2, 0xb5, 0x9c, 0x19, 0xf5, 0x8d, 0x06, 0x14, 0x42, 0xf0, 0xa0, 0xef, 0x3a, 0x03, 0x82, 0xaf,
+	0x40, 0xba, 0x6e, 0xf5, 0xc8, 0xa0, 0x88, 0x56, 0xd5, 0xb5, 0x9c, 0x19, 0x74, 0xf0, 0x1d, 0xc8,
+	0x04, 0xb8, 0xa2, 0xb2, 0xaa, 0xae, 0xe5, 0xb7, 0x16, 0x36, 0xa2, 0x09, 0x37, 0x4a, 0xb6, 0x3f,
+	0x32, 0xb9, 0xda, 0xf8, 0x8b, 0x0a, 0x29, 0x2a, 0xc0, 0x05, 0x50, 0xaa, 0x65, 0x3e, 0x9f, 0x52,
+	0x2d, 0x63, 0x0c, 0x29, 0x3a, 0x54, 0x51, 0x61, 0x12, 0xd6, 0xc6, 0x15, 0x98, 0x2b, 0xdb, 0x83,
+	0x7e, 0xd7, 0x1a, 0x05, 0x53, 0xaa, 0x6c, 0xec, 0x77, 0xc7, 0xc6, 0xde, 0x10, 0x31, 0x15, 0xc7,
+	0xf7, 0x46, 0xa6, 0xf4, 0x19, 0x2e, 0xc2, 0x6c, 0xc9, 0x1d, 0x52, 0x45, 0x31, 0xc5, 0x46, 0x0f,
+	0xbb, 0xf8, 0x63, 0xc8, 0x96, 0x88, 0xe3, 0x7b, 0xae, 0xdd, 0x29, 0xa6, 0x57, 0xd1, 0x5a, 0x7e,
+	0x4b, 0x13, 0x06, 0x6f, 0xba, 0xb6, 0xe3, 0x9b, 0x11, 0x02, 0x6f, 0x40, 0x66, 0xcf, 0x1d, 0x3a,
+	0x9d, 0x41, 0x31, 0xc3, 0xb0, 0xcb, 0x02, 0x96, 0x29, 0x6c, 0xe7, 0x64, 0xcf, 0x3d, 0x35, 0x39,
+	0x8a, 0x6e, 0x69, 0xd7, 0x23, 0x56, 0x71, 0x76, 0x15, 0xad, 0x21, 0x93, 0xb5, 0xf1, 0x03, 0x80,
+	0xa6, 0xe7, 0xf6, 0x89, 0xc7, 0x8c, 0x95, 0x65, 0x1b, 0xba, 0x35, 0xbe, 0xa1, 0x18, 0x11, 0x6c,
+	0x47, 0xf8, 0x44, 0x7f, 0x00, 0x8b, 0x13, 0xfb, 0xc5, 0x1a, 0xa8, 0x5f, 0x90, 0x11, 0xb7, 0x26,
+	0x6d, 0xd2, 0x63, 0xfa, 0xca, 0xea, 0x0e, 0x43, 0x7b, 0x06, 0x9d, 0xfb, 0xca, 0x3d, 0xa4, 0xff,
+	0x18, 0x16, 0xc6, 0xc6, 0xbf, 0xc8, 0xe7, 0xc6, 0x21, 0xe4, 0x85, 0xbd, 0x62, 0x03, 0xd4, 0x9a,
+	0xed, 0x14, 0xd1, 0x14, 0xe3, 0x51, 0x25, 0xc3, 0x58, 0xa7, 0x45, 0x65, 0x2a, 0xc6, 0x3a, 0x35,
+	0x34, 0x28, 0x34, 0xfa, 0xbe, 0xed, 0x3a, 0x21, 0x2d, 0x8d, 0xbf, 0x22, 0x58, 0x88, 0x44, 0x9c,
+	0x7c, 0x3f, 0x82, 0x7c, 0xcb, 0x1d, 0x7a, 0x6d, 0x72, 0x30, 0xea, 0x73, 0x0a, 0xe6, 0xb7, 0xae,
+	0x09, 0x23, 0xc6, 0xda, 0xaa, 0xf3, 0xc2, 0x35, 0x45, 0x34, 0xde, 0x81, 0x5c, 0xa5, 0x67, 0x0f,
+	0x06, 0x74, 0x44, 0x4e, 0xd3, 0x15, 0xe1, 0xd3, 0x50, 0xc7, 0x3e, 0x8c, 0x91, 0x74, 0xce, 0x6a,
+	0xaf, 0x6f, 0xb5, 0xfd, 0x60, 0x4e, 0x75, 0x62, 0xce, 0x58, 0x1b, 0xcc, 0x29, 0xa0, 0x8d, 0x3f,
+	0x20, 0x28, 0xc8, 0x6b, 0x8a, 0x88, 0x8e, 0x04, 0xa2, 0xaf, 0x42, 0x5e, 0x38, 0x54, 0x6e, 0x74,
+	0x51, 0xc4, 0x10, 0x64, 0xd0, 0xf6, 0x6c, 0x66, 0x91, 0xa2, 0xca, 0x11, 0xb1, 0x88, 0xb2, 0xbc,
+	0xf2, 0xe8, 0xf0, 0x91, 0x67, 0x77, 0x18, 0xcb, 0xb3, 0x66, 0xd8, 0xc5, 0x1f, 0xc1, 0x6c, 0xa3,
+	0x55, 0x3b, 0xb0, 0x4e, 0x06, 0xc5, 0x34, 0x5b, 0xfd, 0xa2, 0xb0, 0xfa, 0x40, 0x63, 0x86, 0x08,
+	0x63, 0x0b, 0x32, 0x41, 0x93, 0xb2, 0xe2, 0x71, 0xcc, 0x8a, 0xc7, 0x64, 0x84, 0x97, 0x21, 0x73,
+	0x44, 0x89, 0x10, 0x98, 0x2f, 0x67, 0xf2, 0x9e, 0xf1, 0x6b, 0x04, 0x73, 0xa2, 0xf9, 0xf0, 0x77,
+	0x21, 0x1b, 0xf6, 0xd9, 0xf7, 0x85, 0xad, 0xa5, 0x04, 0x4b, 0x9b, 0x11, 0xe8, 0x52, 0x0c, 0x70,
+	0x05, 0xd2, 0x87, 0x8e, 0xed, 0x0f, 0xb8, 0x93, 0x07, 0x1d, 0xe3, 0xb7, 0x08, 0x0a, 0xf2, 0x09,
+	0xe1, 0x1d, 0x80, 0x58, 0xc2, 0xd7, 0x77, 0x35, 0xf1, 0x40, 0x4d, 0x01, 0xf8, 0x06, 0xd7, 0xf8,
+	0x3d, 0x58, 0xa2, 0x7e, 0xff, 0x88, 0xb8, 0x3d, 0x42, 0xfd, 0x3d, 0x0e, 0xcc, 0x54, 0x2c, 0xb0,
+	0x25, 0xea, 0x1b, 0x0f, 0xe1, 0x8a, 0xfc, 0x09, 0xf7, 0x90, 0x0d, 0xc8, 0x36, 0xdd, 0xee, 0xe8,
+	0xc4, 0x75, 0x42, 0xf7, 0xc0, 0x92, 0xc3, 0x31, 0x95, 0x19, 0x61, 0x8c, 0x4d, 0x98, 0xe5, 0x6d,
+	0xfc, 0x01, 0xa4, 0x9b, 0x96, 0xff, 0x32, 0xfc, 0x4e, 0x0c, 0xe1, 0x54, 0x6e, 0x06, 0x5a, 0x63,
+	0x13, 0x52, 0xb4, 0x81, 0xd7, 0x20, 0xc3, 0xfc, 0x37, 0xc4, 0x4f, 0x3a, 0x36, 0xd7, 0x1b, 0xef,
+	0x41, 0x9a, 0xb5, 0xf0, 0x1c, 0xa0, 0x67, 0x6c, 0x27, 0xc8, 0x44, 0xcf, 0x68, 0xef, 0x39, 0xb3,
+	0x22, 0x32, 0xd1, 0x73, 0xe3, 0x1b, 0x05, 0x56, 0x28, 0x5b, 0x3b, 0xa4, 0x13, 0xf9, 0xde, 0x39,
+	0x0c, 0x81, 0x6f, 0x02, 0xc4, 0x0e, 0xc6, 0x0f, 0x45, 0x90, 0x48, 0x54, 0x54, 0xcf, 0x43, 0xc5,
+	0xdb, 0x50, 0x08, 0xdb, 0xbb, 0x3d, 0x9a, 0x27, 0xd8, 0x59, 0x21, 0x73, 0x4c, 0x8a, 0x8d, 0x98,
+	0xf3, 0xf4, 0x14, 0x59, 0xfe, 0xc8, 0x99, 0x92, 0x0c, 0x6f, 0x43, 0xb6, 0xe2, 0xb4, 0x5d, 0x1a,
+	0x2c, 0x59, 0xce, 0x28, 0x48, 0x11, 0x87, 0x6e, 0x37, 0x54, 0x9b, 0x11, 0xd0, 0xf8, 0x3d, 0x82,
+	0xe2, 0xa4, 0x25, 0x5e, 0xef, 0x7c, 0xf1, 0x3b, 0xe3, 0x41, 0x0f, 0x89, 0xb1, 0x6d, 0x1d, 0x52,
+	0x2c, 0x60, 0xa8, 0x13, 0xf9, 0xac, 0xe4, 0x32, 0xe2, 0x53, 0xad, 0xc9, 0x30, 0x82, 0xf3, 0xa7,
+	0x56, 0xd5, 0x35, 0x25, 0x72, 0xfe, 0xbf, 0x2b, 0xf0, 0x0e, 0x5f, 0x6e, 0xc9, 0x75, 0xda, 0x34,
+	0x59, 0x5a, 0xfe, 0xff, 0xc5, 0xe9, 0xfd, 0x00, 0xb2, 0xad, 0x36, 0x71, 0x2c, 0xcf, 0x76, 0x79,
+	0xc6, 0xbf, 0x9e, 0x30, 0x79, 0x08, 0x31, 0x23, 0x30, 0x35, 0xfa, 0x81, 0xeb, 0x5b, 0xdd, 0x66,
+	0x6d, 0x6b, 0x87, 0x65, 0xff, 0xac, 0x19, 0x0b, 0x24, 0x52, 0x64, 0xcf, 0x4b, 0x8a, 0xbf, 0xa5,
+	0xe0, 0xc6, 0x14, 0x2b, 0xbf, 0x26, 0x33, 0x6e, 0x43, 0x41, 0x1e, 0x89, 0xd3, 0x63, 0x4c, 0x4a,
+	0x83, 0x5a, 0xd3, 0xb3, 0x7b, 0x96, 0x37, 0x62, 0xdb, 0x51, 0x19, 0x48, 0x14, 0xd1, 0x8c, 0xd6,
+	0xac, 0x7f, 0x72, 0x97, 0xf1, 0x02, 0x99, 0xac, 0x1d, 0xc8, 0x1a, 0xdb, 0xc5, 0x74, 0x28, 0x6b,
+	0x6c, 0x33, 0x59, 0xab, 0x71, 0xb7, 0x98, 0xe1, 0xb2, 0x56, 0xe3, 0x2e, 0x4d, 0x32, 0xad, 0xc6,
+	0x6e, 0x71, 0x96, 0x89, 0x68, 0x13, 0x7f, 0x0c, 0x8b, 0x7b, 0xd6, 0x80, 0x74, 0x6d, 0x87, 0xc4,
+	0x46, 0xcc, 0x31, 0xfd, 0xa4, 0x22, 0x62, 0x30, 0x5c, 0x88, 0xc1, 0x79, 0x91, 0xc1, 0x74, 0x46,
+	0x61, 0x3b, 0x1c, 0x32, 0xc7, 0x20, 0x93, 0x0a, 0x4a, 0x59, 0xba, 0x43, 0x0e, 0x9b, 0x67, 0x30,
+	0x41, 0x12, 0xe8, 0x1b, 0xdb, 0x5c, 0x5f, 0x08, 0xf5, 0x8d, 0x6d, 0x41, 0xdf, 0x6a, 0x84, 0xdf,
+	0x2f, 0x70, 0x7d, 0x24, 0xa1, 0xe4, 0x69, 0x35, 0x76, 0xb9, 0x5a, 0x63, 0xea, 0x58, 0x80, 0xef,
+	0xc1, 0xca, 0x84, 0x11, 0x38, 0x76, 0x91, 0x61, 0xa7, 0xa9, 0xf7, 0x53, 0xd9, 0xac, 0x96, 0x13,
+	0x78, 0x68, 0xd4, 0x41, 0x1b, 0xe7, 0x30, 0xbe, 0x2f, 0x86, 0x8b, 0x80, 0x45, 0xef, 0xc8, 0x2c,
+	0xea, 0x0e, 0x7d, 0xcb, 0xf1, 0x23, 0xcf, 0x8b, 0xe1, 0x46, 0x1f, 0x16, 0x27, 0xf4, 0x17, 0xbf,
+	0x09, 0x2c, 0x43, 0x86, 0x3b, 0x6e, 0x90, 0x1a, 0x78, 0x8f, 0x92, 0x87, 0x39, 0x6a, 0x90, 0x54,
+	0x59, 0xdb, 0xf8, 0x73, 0x1c, 0x29, 0x9b, 0x6e, 0x7f, 0xd8, 0x65, 0x8c, 0x7d, 0x2b, 0x61, 0x47,
+	0xf4, 0xe9, 0xd4, 0x79, 0x7d, 0xfa, 0x1b, 0x05, 0xae, 0x25, 0x2c, 0xff, 0x35, 0xfd, 0x99, 0xf2,
+	0x2a, 0x1a, 0x85, 0xfb, 0xb2, 0x20, 0xc1, 0xef, 0xc3, 0x7c, 0xcd, 0xf5, 0x7c, 0xab, 0x4b, 0x9f,
+	0x63, 0x96, 0x4f, 0xb8, 0x27, 0xcb, 0xc2, 0xc8, 0x9f, 0x52, 0x17, 0xf2, 0xa7, 0xb4, 0xe4, 0x4f,
+	0x9b, 0xb0, 0x24, 0x0d, 0xca, 0x41, 0x19, 0x06, 0x4a, 0x52, 0x19, 0xbf, 0x40, 0x90, 0x17, 0xc6,
+	0xa7, 0x8f, 0xc3, 0x67, 0x9b, 0xfc, 0xa6, 0xa0, 0x3c, 0xdb, 0xa4, 0xfd, 0xe7, 0x9b, 0x9c, 0x10,
+	0xca, 0x73, 0xd6, 0x2f, 0x9f, 0xb2, 0x93, 0x41, 0xa6, 0x52, 0x3e, 0x65, 0xfd, 0x11, 0x8f, 0xf4,
+	0x4a, 0x99, 0x3d, 0x2e, 0xeb, 0xa7, 0x2c, 0xa6, 0xa7, 0x4d, 0xa5, 0xce, 0xf4, 0xf5, 0x51, 0x31,
+	0xc3, 0xfb, 0x23, 0x6a, 0xab, 0x12, 0xe9, 0x76, 0xf9, 0x6b, 0x2e, 0x08, 0x3e, 0x82, 0xc4, 0xf8,
+	0xb7, 0x02, 0x57, 0x82, 0x9b, 0x5f, 0x6b, 0xd8, 0xa3, 0xfe, 0xff, 0x3f, 0x9f, 0xcb, 0xa4, 0x94,
+	0x94, 0x19, 0x4f, 0x49, 0x47, 0x70, 0x55, 0x8a, 0xfa, 0x21, 0x09, 0x59, 0xf2, 0x2a, 0x6c, 0xad,
+	0x4a, 0x34, 0x48, 0xc0, 0x99, 0xc9, 0x9f, 0x4b, 0x19, 0x34, 0x7b, 0x81, 0x0c, 0x6a, 0xfc, 0x46,
+	0x81, 0xab, 0x63, 0x07, 0xc0, 0x87, 0x94, 0x69, 0x1e, 0x50, 0x44, 0x90, 0xb0, 0xb4, 0x66, 0xfb,
+	0x23, 0xc9, 0x15, 0x98, 0xd1, 0x64, 0x29, 0x35, 0x1a, 0x95, 0x54, 0x4e, 0xfb, 0xee, 0x60, 0xe8,
+	0x11, 0x4e, 0x26, 0x49, 0x46, 0x5d, 0x86, 0xd9, 0x28, 0x02, 0x05, 0xf6, 0x97, 0x85, 0xd4, 0x0d,
+	0xe8, 0x57, 0xd5, 0x87, 0xcc, 0xf0, 0xc8, 0xe4, 0x3d, 0xfa, 0x20, 0x63, 0xc0, 0xea, 0x43, 0x66,
+	0x70, 0x64, 0x86, 0x5d, 0x46, 0x3f, 0xdb, 0x1f, 0x95, 0x09, 0xbb, 0x6e, 0x07, 0xe5, 0x01, 0x41,
+	0x42, 0x53, 0x2e, 0x83, 0x72, 0x40, 0x96, 0x01, 0x44, 0x91, 0xf1, 0x2f, 0x15, 0x0a, 0x65, 0xab,
+	0x67, 0x9d, 0x90, 0xb7, 0x73, 0xcd, 0x9a, 0x4a, 0x98, 0xd4, 0xb7, 0x23, 0x8c, 0x06, 0xea, 0x51,
+	0xeb, 0x09, 0x37, 0x24, 0x6d, 0xe2, 0x75, 0xd0, 0xaa, 0x4e, 0xdb, 0xed, 0x91, 0x4a, 0xd7, 0x1a,
+	0xf8, 0x36, 0x1d, 0x97, 0x9b, 0x73, 0x42, 0x8e, 0xd7, 0x60, 0xc1, 0x24, 0x2f, 0x88, 0x47, 0x9c,
+	0x36, 0x09, 0x94, 0xdc, 0xb8, 0xe3, 0x62, 0x7c, 0x08, 0x05, 0x5e, 0x03, 0x0a, 0x04, 0x61, 0x29,
+	0xe6, 0x3b, 0xc2, 0xc2, 0x65, 0xfb, 0x6e, 0xc8, 0xf8, 0xa0, 0x30, 0x33, 0x36, 0x08, 0x25, 0x55,
+	0xd9, 0x1e, 0xb4, 0xa9, 0x90, 0x85, 0xd8, 0x5c, 0x40, 0x2a, 0x51, 0xa6, 0xef, 0xc2, 0x52, 0xc2,
+	0x50, 0xaf, 0xaa, 0xc1, 0x20, 0xb1, 0x06, 0xf3, 0x73, 0x04, 0x0b, 0xd1, 0xea, 0xb8, 0xe5, 0x84,
+	0x22, 0x17, 0x92, 0x8b, 0x5c, 0xdc, 0xa6, 0x4a, 0x6c, 0xd3, 0x55, 0xc8, 0x33, 0xb6, 0x05, 0x43,
+	0x70, 0xea, 0x8b, 0x22, 0xba, 0x91, 0x80, 0x6e, 0x1c, 0x12, 0x10, 0x5f, 0x92, 0x19, 0x7f, 0x42,
+	0x80, 0xf7, 0xdd, 0xe3, 0xa6, 0xe7, 0x9e, 0x78, 0x64, 0xf0, 0x76, 0x78, 0x28, 0x06, 0x98, 0xd4,
+	0x45, 0x02, 0xcc, 0xa7, 0xb0, 0x24, 0xad, 0x9d, 0x5b, 0xf1, 0x43, 0x48, 0xb7, 0x7c, 0xcb, 0x0f,
+	0x56, 0x2e, 0xcf, 0xbe, 0xef, 0x1e, 0x33, 0x95, 0x19, 0x20, 0xa8, 0xc1, 0x6b, 0x64, 0x30, 0xb0,
+	0x4e, 0xc2, 0x8d, 0x84, 0x5d, 0xe3, 0x97, 0x08, 0x6e, 0xb4, 0x86, 0xc7, 0x3d, 0xdb, 0x97, 0x48,
+	0xbe, 0xef, 0x1e, 0x5f, 0x86, 0x8d, 0xc4, 0x2d, 0xab, 0x17, 0xd9, 0xf2, 0x1f, 0x11, 0xa8, 0xfb,
+	0xee, 0xf1, 0x44, 0xe5, 0x55, 0x5c, 0x8c, 0x72, 0xe6, 0x62, 0xd4, 0x89, 0xc5, 0x44, 0xf6, 0x4a,
+	0x5d, 0xc4, 0x5e, 0x69, 0xc9, 0x5e, 0x54, 0x73, 0xd8, 0xef, 0x58, 0x3e, 0xe9, 0x30, 0xcf, 0x56,
+	0xcd, 0xb0, 0x6b, 0xdc, 0x82, 0xf9, 0x47, 0xc4, 0x17, 0x0c, 0x37, 0xb6, 0x76, 0xc3, 0x00, 0xad,
+	0x64, 0x39, 0x6d, 0xd2, 0x3d, 0x03, 0xb3, 0x08, 0x0b, 0x4f, 0xec, 0x01, 0x1d, 0x25, 0xaa, 0x2d,
+	0x7e, 0x1f, 0xb4, 0x58, 0xc4, 0x8f, 0xde, 0x80, 0x14, 0xed, 0xf3, 0xbb, 0x56, 0x41, 0xde, 0x89,
+	0xc9, 0x74, 0xc6, 0x11, 0x14, 0xe9, 0x77, 0x25, 0xab, 0xfd, 0x92, 0x74, 0x4c, 0x32, 0x18, 0x76,
+	0xfd, 0xcb, 0xe0, 0xbd, 0x61, 0xc2, 0xb5, 0x84, 0x71, 0xf9, 0xc2, 0x76, 0x60, 0x96, 0x8b, 0xf8,
+	0xda, 0xc4, 0xf3, 0x16, 0x3f, 0x61, 0xe5, 0xc7, 0x10, 0x6b, 0xdc, 0x81, 0x25, 0x51, 0x19, 0x2e,
+	0x73, 0xa2, 0xaa, 0x67, 0xfc, 0x03, 0x81, 0x26, 0x22, 0xe9, 0x30, 0x93, 0xb0, 0x6f, 0x45, 0x13,
+	0x7d, 0xcc, 0x4d, 0xb3, 0xc2, 0x63, 0x19, 0x43, 0xaa, 0x65, 0xff, 0x2c, 0x20, 0x85, 0x6a, 0xb2,
+	0x36, 0x0b, 0x66, 0x1e, 0x11, 0x19, 0xc1, 0xbb, 0x34, 0x30, 0x55, 0x9d, 0xda, 0x6e, 0xf3, 0x88,
+	0x78, 0x2c, 0x4a, 0xcc, 0x06, 0x77, 0x1d, 0x51, 0x16, 0x25, 0xe4, 0x32, 0xcb, 0x9c, 0x39, 0x9e,
+	0x90, 0xcb, 0x86, 0x03, 0x37, 0xab, 0xce, 0x57, 0x56, 0xd7, 0xa6, 0xe4, 0x4a, 0x3c, 0xc3, 0x4b,
+	0xdd, 0xb5, 0xb1, 0x03, 0xb7, 0xa6, 0xce, 0xc7, 0xcf, 0x16, 0x43, 0xea, 0x31, 0x19, 0x85, 0x3f,
+	0xa6, 0xb0, 0xb6, 0xf1, 0x0c, 0xf4, 0xe8, 0x51, 0x45, 0x6f, 0xc3, 0xc1, 0x9d, 0xf4, 0x32, 0x68,
+	0x46, 0xe0, 0x7a, 0xe2, 0xc8, 0x91, 0x07, 0x5c, 0x4e, 0x2d, 0xff, 0x9f, 0x08, 0x16, 0x6a, 0x56,
+	0xbf, 0xd5, 0xb6, 0xba, 0xe4, 0x3c, 0xcb, 0x96, 0xeb, 0xb1, 0xca, 0x79, 0xeb, 0xb1, 0x17, 0x4e,
+	0x16, 0xb2, 0x79, 0x52, 0x67, 0x46, 0xd6, 0xf4, 0x45, 0x22, 0xeb, 0x13, 0xd0, 0xe2, 0xfd, 0xc6,
+	0x37, 0x99, 0xd0, 0x98, 0x28, 0x30, 0x9d, 0x16, 0x9b, 0x0e, 0x31, 0x43, 0xd1, 0x0c, 0x5f, 0x1a,
+	0xfa, 0x4d, 0x9f, 0x67, 0xe0, 0xa0, 0xb3, 0xfe, 0x2b, 0x04, 0xd9, 0x30, 0x78, 0xe2, 0x2b, 0xa0,
+	0x1d, 0xd6, 0x1f, 0xd7, 0x1b, 0x3f, 0xa9, 0x7f, 0xb6, 0xdf, 0xd8, 0x6b, 0x1d, 0xec, 0x1e, 0x54,
+	0xb4, 0x19, 0x0c, 0x90, 0x79, 0x3a, 0x24, 0x43, 0xd2, 0xd1, 0x10, 0xbe, 0x0a, 0x8b, 0xd2, 0xa1,
+	0xd2, 0xa7, 0xa5, 0xa6, 0xe0, 0x79, 0xc8, 0x05, 0x39, 0xc8, 0x27, 0x1d, 0x4d, 0xc5, 0x79, 0x98,
+	0x35, 0x87, 0x8e, 0x43, 0x75, 0x29, 0xbc, 0x00, 0xf9, 0xb2, 0xfb, 0xb5, 0xd3, 0x75, 0x2d, 0x06,
+	0x4e, 0xd3, 0xf1, 0x02, 0x7e, 0x6a, 0x19, 0xda, 0x7e, 0x68, 0xd9, 0x5d, 0xd2, 0xd1, 0x66, 0xf1,
+	0x1c, 0x64, 0x83, 0xf0, 0x4a, 0x3a, 0x5a, 0x76, 0xbd, 0x11, 0x1b, 0x5c, 0x5c, 0x57, 0xa5, 0x56,
+	0x6d, 0xb5, 0xaa, 0x8d, 0xba, 0x36, 0x83, 0x73, 0x90, 0x6e, 0xd6, 0xb6, 0x3e, 0xdb, 0xd1, 0x10,
+	0x9e, 0x05, 0xb5, 0xfe, 0xc9, 0xb6, 0xa6, 0xb0, 0x46, 0xe3, 0x54, 0x53, 0x69, 0xa3, 0xd5, 0x38,
+	0xd5, 0x52, 0xb4, 0x71, 0xd4, 0x28, 0x69, 0xe9, 0xf5, 0x7b, 0x30, 0x27, 0x3e, 0x8d, 0xf1, 0x12,
+	0x2c, 0xf0, 0xe7, 0x6c, 0x28, 0xd2, 0x66, 0xa8, 0x90, 0x3f, 0x0d, 0x23, 0x21, 0x5a, 0xff, 0x5c,
+	0xa4, 0x0c, 0x5e, 0x06, 0x1c, 0x2e, 0xa6, 0x5a, 0x6b, 0xee, 0x96, 0x0e, 0x0e, 0x9e, 0x37, 0xa9,
+	0x99, 0xe6, 0x85, 0x6a, 0x86, 0x86, 0x30, 0x1e, 0xaf, 0x78, 0x69, 0x0a, 0x5e, 0x81, 0x25, 0x76,
+	0xa9, 0x19, 0x53, 0xa8, 0xeb, 0x87, 0x53, 0x6e, 0xb8, 0xf8, 0x5d, 0xb8, 0x11, 0x4e, 0x56, 0x6a,
+	0xd4, 0x4b, 0x95, 0xfa, 0x81, 0xb9, 0x7b, 0x50, 0x6d, 0xd4, 0xcd, 0x4a, 0xab, 0xd9, 0xa8, 0xb7,
+	0xe8, 0xbc, 0x0b, 0x90, 0x7f, 0xec, 0x91, 0xaf, 0x07, 0x5f, 0xd8, 0x5b, 0x9b, 0x9b, 0x3f, 0xd4,
+	0x10, 0xce, 0x42, 0xea, 0x51, 0xa5, 0x56, 0xd3, 0x94, 0xad, 0xdf, 0x15, 0x82, 0xe0, 0xb4, 0xfb,
+	0x14, 0x3f, 0x08, 0x7f, 0x33, 0xc5, 0x45, 0xf9, 0x07, 0xc0, 0xf8, 0xb7, 0x59, 0xfd, 0x5a, 0x82,
+	0x26, 0x58, 0x87, 0x31, 0x83, 0xf7, 0x60, 0x96, 0xff, 0x40, 0x86, 0x45, 0x9c, 0xfc, 0x3b, 0x9a,
+	0xae, 0x27, 0xa9, 0xa2, 0x31, 0x9e, 0xc2, 0x9c, 0xf8, 0x3b, 0x02, 0xbe, 0x29, 0x4f, 0x38, 0xfe,
+	0x9b, 0x84, 0x7e, 0x6b, 0xaa, 0x3e, 0x1a, 0xf2, 0xa7, 0xa0, 0x8d, 0x97, 0xaf, 0xb1, 0x31, 0x56,
+	0x0d, 0x49, 0xa8, 0xf2, 0xeb, 0xef, 0x9d, 0x89, 0x89, 0x86, 0x27, 0xb0, 0xdc, 0xf2, 0x3d, 0x62,
+	0xf5, 0xde, 0xe0, 0x24, 0x9b, 0x08, 0xbf, 0x80, 0xa5, 0x84, 0x58, 0x89, 0x3f, 0x48, 0x88, 0x08,
+	0x93, 0x51, 0x5a, 0xbf, 0xfd, 0x2a, 0x58, 0xb4, 0x9d, 0x2e, 0x5c, 0x4d, 0xac, 0xeb, 0xe2, 0x3b,
+	0x93, 0x2b, 0x4d, 0xac, 0xaf, 0xeb, 0x6b, 0xaf, 0x06, 0x46, 0xb3, 0xf9, 0x70, 0x5d, 0x32, 0xde,
+	0x7f, 0x61, 0xce, 0x4d, 0x84, 0x2b, 0x90, 0x0d, 0xe3, 0x23, 0x16, 0xe9, 0x38, 0x96, 0x24, 0xf4,
+	0xeb, 0x89, 0xba, 0x68, 0xf1, 0x9f, 0xc3, 0xe2, 0x44, 0xb9, 0x0c, 0x27, 0x1c, 0xe8, 0x44, 0x2d,
+	0x50, 0x7f, 0xff, 0x6c, 0x50, 0x34, 0xc3, 0x4b, 0x58, 0x91, 0xcc, 0xf3, 0x86, 0xe6, 0xd9, 0x44,
+	0xf8, 0x00, 0xe6, 0xa5, 0xfa, 0x06, 0xbe, 0x35, 0x91, 0xf0, 0xe4, 0xd2, 0x93, 0xbe, 0x3a, 0x1d,
+	0x20, 0x46, 0x84, 0xf0, 0x05, 0x77, 0x6d, 0xea, 0x4b, 0x56, 0xd7, 0x93, 0x54, 0xd1, 0x18, 0x4d,
+	0xc8, 0x0b, 0x2f, 0x23, 0x7c, 0x43, 0xbe, 0x08, 0x8f, 0xbd, 0xf6, 0xf4, 0x9b, 0xd3, 0xd4, 0xd2,
+	0x5e, 0x97, 0x93, 0x9f, 0x43, 0x58, 0xa4, 0xd1, 0x99, 0x2f, 0x26, 0x7d, 0xec, 0x3e, 0x6e, 0xcc,
+	0xe0, 0xbb, 0x90, 0x09, 0xde, 0x06, 0x52, 0xf8, 0x94, 0x9e, 0x0b, 0x09, 0x5f, 0xdd, 0x87, 0x5c,
+	0xf4, 0x60, 0xc0, 0xf2, 0x45, 0x5a, 0x7e, 0x46, 0x24, 0x7c, 0x5b, 0x81, 0x6c, 0xf8, 0x6a, 0x90,
+	0x68, 0x3c, 0xf6, 0xba, 0xd0, 0xaf, 0x27, 0xea, 0x44, 0x1a, 0x4f, 0x5c, 0xf6, 0x25, 0x7a, 0x4d,
+	0x7b, 0x62, 0xe8, 0xef, 0x9f, 0x0d, 0x8a, 0x66, 0xa8, 0xc1, 0x9c, 0xa8, 0x92, 0x83, 0xfa, 0xe4,
+	0x9b, 0x40, 0x3f, 0xeb, 0x41, 0x61, 0xcc, 0x60, 0x0f, 0x56, 0xa6, 0xdc, 0x63, 0xf1, 0x87, 0x22,
+	0x29, 0xcf, 0xbc, 0x5b, 0xeb, 0xeb, 0xe7, 0x81, 0x86, 0x5b, 0xd8, 0xcb, 0x7f, 0x1a, 0xff, 0x65,
+	0xe9, 0x38, 0xc3, 0xfe, 0xc4, 0xb4, 0xfd, 0x9f, 0x01, 0x00, 0x5c, 0x1a, 0x1b, 0xd5, 0xd4, 0x24,
+	0x00, 0x00,
 }
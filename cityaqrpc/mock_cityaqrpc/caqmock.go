@@ -9,6 +9,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	grpc "github.com/johanbrandhorst/grpc-wasm"
 	context "golang.org/x/net/context"
+	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
 )
 
@@ -55,6 +56,26 @@ func (mr *MockCityAQClientMockRecorder) Cities(ctx, in interface{}, opts ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cities", reflect.TypeOf((*MockCityAQClient)(nil).Cities), varargs...)
 }
 
+// Options mocks base method
+func (m *MockCityAQClient) Options(ctx context.Context, in *cityaqrpc.OptionsRequest, opts ...grpc.CallOption) (*cityaqrpc.OptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Options", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.OptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Options indicates an expected call of Options
+func (mr *MockCityAQClientMockRecorder) Options(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockCityAQClient)(nil).Options), varargs...)
+}
+
 // CityGeometry mocks base method
 func (m *MockCityAQClient) CityGeometry(ctx context.Context, in *cityaqrpc.CityGeometryRequest, opts ...grpc.CallOption) (*cityaqrpc.CityGeometryResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +116,26 @@ func (mr *MockCityAQClientMockRecorder) GriddedEmissions(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedEmissions", reflect.TypeOf((*MockCityAQClient)(nil).GriddedEmissions), varargs...)
 }
 
+// StreamGriddedEmissions mocks base method
+func (m *MockCityAQClient) StreamGriddedEmissions(ctx context.Context, in *cityaqrpc.GriddedEmissionsRequest, opts ...grpc.CallOption) (cityaqrpc.CityAQ_StreamGriddedEmissionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamGriddedEmissions", varargs...)
+	ret0, _ := ret[0].(cityaqrpc.CityAQ_StreamGriddedEmissionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamGriddedEmissions indicates an expected call of StreamGriddedEmissions
+func (mr *MockCityAQClientMockRecorder) StreamGriddedEmissions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedEmissions", reflect.TypeOf((*MockCityAQClient)(nil).StreamGriddedEmissions), varargs...)
+}
+
 // EmissionsGridBounds mocks base method
 func (m *MockCityAQClient) EmissionsGridBounds(ctx context.Context, in *cityaqrpc.EmissionsGridBoundsRequest, opts ...grpc.CallOption) (*cityaqrpc.EmissionsGridBoundsResponse, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +176,26 @@ func (mr *MockCityAQClientMockRecorder) GriddedConcentrations(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedConcentrations", reflect.TypeOf((*MockCityAQClient)(nil).GriddedConcentrations), varargs...)
 }
 
+// StreamGriddedConcentrations mocks base method
+func (m *MockCityAQClient) StreamGriddedConcentrations(ctx context.Context, in *cityaqrpc.GriddedConcentrationsRequest, opts ...grpc.CallOption) (cityaqrpc.CityAQ_StreamGriddedConcentrationsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamGriddedConcentrations", varargs...)
+	ret0, _ := ret[0].(cityaqrpc.CityAQ_StreamGriddedConcentrationsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamGriddedConcentrations indicates an expected call of StreamGriddedConcentrations
+func (mr *MockCityAQClientMockRecorder) StreamGriddedConcentrations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedConcentrations", reflect.TypeOf((*MockCityAQClient)(nil).StreamGriddedConcentrations), varargs...)
+}
+
 // MapScale mocks base method
 func (m *MockCityAQClient) MapScale(ctx context.Context, in *cityaqrpc.MapScaleRequest, opts ...grpc.CallOption) (*cityaqrpc.MapScaleResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +236,26 @@ func (mr *MockCityAQClientMockRecorder) GriddedPopulation(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedPopulation", reflect.TypeOf((*MockCityAQClient)(nil).GriddedPopulation), varargs...)
 }
 
+// StreamGriddedPopulation mocks base method
+func (m *MockCityAQClient) StreamGriddedPopulation(ctx context.Context, in *cityaqrpc.GriddedPopulationRequest, opts ...grpc.CallOption) (cityaqrpc.CityAQ_StreamGriddedPopulationClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamGriddedPopulation", varargs...)
+	ret0, _ := ret[0].(cityaqrpc.CityAQ_StreamGriddedPopulationClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamGriddedPopulation indicates an expected call of StreamGriddedPopulation
+func (mr *MockCityAQClientMockRecorder) StreamGriddedPopulation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedPopulation", reflect.TypeOf((*MockCityAQClient)(nil).StreamGriddedPopulation), varargs...)
+}
+
 // ImpactSummary mocks base method
 func (m *MockCityAQClient) ImpactSummary(ctx context.Context, in *cityaqrpc.ImpactSummaryRequest, opts ...grpc.CallOption) (*cityaqrpc.ImpactSummaryResponse, error) {
 	m.ctrl.T.Helper()
@@ -195,145 +276,1484 @@ func (mr *MockCityAQClientMockRecorder) ImpactSummary(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImpactSummary", reflect.TypeOf((*MockCityAQClient)(nil).ImpactSummary), varargs...)
 }
 
-// MockCityAQServer is a mock of CityAQServer interface
-type MockCityAQServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockCityAQServerMockRecorder
+// Damages mocks base method
+func (m *MockCityAQClient) Damages(ctx context.Context, in *cityaqrpc.DamagesRequest, opts ...grpc.CallOption) (*cityaqrpc.DamagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Damages", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.DamagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockCityAQServerMockRecorder is the mock recorder for MockCityAQServer
-type MockCityAQServerMockRecorder struct {
-	mock *MockCityAQServer
+// Damages indicates an expected call of Damages
+func (mr *MockCityAQClientMockRecorder) Damages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Damages", reflect.TypeOf((*MockCityAQClient)(nil).Damages), varargs...)
 }
 
-// NewMockCityAQServer creates a new mock instance
-func NewMockCityAQServer(ctrl *gomock.Controller) *MockCityAQServer {
-	mock := &MockCityAQServer{ctrl: ctrl}
-	mock.recorder = &MockCityAQServerMockRecorder{mock}
-	return mock
+// JobProgress mocks base method
+func (m *MockCityAQClient) JobProgress(ctx context.Context, in *cityaqrpc.JobProgressRequest, opts ...grpc.CallOption) (cityaqrpc.CityAQ_JobProgressClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JobProgress", varargs...)
+	ret0, _ := ret[0].(cityaqrpc.CityAQ_JobProgressClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockCityAQServer) EXPECT() *MockCityAQServerMockRecorder {
-	return m.recorder
+// JobProgress indicates an expected call of JobProgress
+func (mr *MockCityAQClientMockRecorder) JobProgress(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobProgress", reflect.TypeOf((*MockCityAQClient)(nil).JobProgress), varargs...)
 }
 
-// Cities mocks base method
-func (m *MockCityAQServer) Cities(arg0 context.Context, arg1 *cityaqrpc.CitiesRequest) (*cityaqrpc.CitiesResponse, error) {
+// SubmitConcentrationJob mocks base method
+func (m *MockCityAQClient) SubmitConcentrationJob(ctx context.Context, in *cityaqrpc.SubmitConcentrationJobRequest, opts ...grpc.CallOption) (*cityaqrpc.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Cities", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.CitiesResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitConcentrationJob", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Cities indicates an expected call of Cities
-func (mr *MockCityAQServerMockRecorder) Cities(arg0, arg1 interface{}) *gomock.Call {
+// SubmitConcentrationJob indicates an expected call of SubmitConcentrationJob
+func (mr *MockCityAQClientMockRecorder) SubmitConcentrationJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cities", reflect.TypeOf((*MockCityAQServer)(nil).Cities), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitConcentrationJob", reflect.TypeOf((*MockCityAQClient)(nil).SubmitConcentrationJob), varargs...)
 }
 
-// CityGeometry mocks base method
-func (m *MockCityAQServer) CityGeometry(arg0 context.Context, arg1 *cityaqrpc.CityGeometryRequest) (*cityaqrpc.CityGeometryResponse, error) {
+// GetJob mocks base method
+func (m *MockCityAQClient) GetJob(ctx context.Context, in *cityaqrpc.GetJobRequest, opts ...grpc.CallOption) (*cityaqrpc.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CityGeometry", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.CityGeometryResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJob", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CityGeometry indicates an expected call of CityGeometry
-func (mr *MockCityAQServerMockRecorder) CityGeometry(arg0, arg1 interface{}) *gomock.Call {
+// GetJob indicates an expected call of GetJob
+func (mr *MockCityAQClientMockRecorder) GetJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CityGeometry", reflect.TypeOf((*MockCityAQServer)(nil).CityGeometry), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockCityAQClient)(nil).GetJob), varargs...)
 }
 
-// GriddedEmissions mocks base method
-func (m *MockCityAQServer) GriddedEmissions(arg0 context.Context, arg1 *cityaqrpc.GriddedEmissionsRequest) (*cityaqrpc.GriddedEmissionsResponse, error) {
+// CancelJob mocks base method
+func (m *MockCityAQClient) CancelJob(ctx context.Context, in *cityaqrpc.CancelJobRequest, opts ...grpc.CallOption) (*cityaqrpc.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GriddedEmissions", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.GriddedEmissionsResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelJob", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GriddedEmissions indicates an expected call of GriddedEmissions
-func (mr *MockCityAQServerMockRecorder) GriddedEmissions(arg0, arg1 interface{}) *gomock.Call {
+// CancelJob indicates an expected call of CancelJob
+func (mr *MockCityAQClientMockRecorder) CancelJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedEmissions", reflect.TypeOf((*MockCityAQServer)(nil).GriddedEmissions), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockCityAQClient)(nil).CancelJob), varargs...)
 }
 
-// EmissionsGridBounds mocks base method
-func (m *MockCityAQServer) EmissionsGridBounds(arg0 context.Context, arg1 *cityaqrpc.EmissionsGridBoundsRequest) (*cityaqrpc.EmissionsGridBoundsResponse, error) {
+// ListJobs mocks base method
+func (m *MockCityAQClient) ListJobs(ctx context.Context, in *cityaqrpc.ListJobsRequest, opts ...grpc.CallOption) (*cityaqrpc.ListJobsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EmissionsGridBounds", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.EmissionsGridBoundsResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListJobs", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.ListJobsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EmissionsGridBounds indicates an expected call of EmissionsGridBounds
-func (mr *MockCityAQServerMockRecorder) EmissionsGridBounds(arg0, arg1 interface{}) *gomock.Call {
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockCityAQClientMockRecorder) ListJobs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmissionsGridBounds", reflect.TypeOf((*MockCityAQServer)(nil).EmissionsGridBounds), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockCityAQClient)(nil).ListJobs), varargs...)
 }
 
-// GriddedConcentrations mocks base method
-func (m *MockCityAQServer) GriddedConcentrations(arg0 context.Context, arg1 *cityaqrpc.GriddedConcentrationsRequest) (*cityaqrpc.GriddedConcentrationsResponse, error) {
+// ListCachedResults mocks base method
+func (m *MockCityAQClient) ListCachedResults(ctx context.Context, in *cityaqrpc.ListCachedResultsRequest, opts ...grpc.CallOption) (*cityaqrpc.ListCachedResultsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GriddedConcentrations", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.GriddedConcentrationsResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCachedResults", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.ListCachedResultsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GriddedConcentrations indicates an expected call of GriddedConcentrations
-func (mr *MockCityAQServerMockRecorder) GriddedConcentrations(arg0, arg1 interface{}) *gomock.Call {
+// ListCachedResults indicates an expected call of ListCachedResults
+func (mr *MockCityAQClientMockRecorder) ListCachedResults(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedConcentrations", reflect.TypeOf((*MockCityAQServer)(nil).GriddedConcentrations), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCachedResults", reflect.TypeOf((*MockCityAQClient)(nil).ListCachedResults), varargs...)
 }
 
-// MapScale mocks base method
-func (m *MockCityAQServer) MapScale(arg0 context.Context, arg1 *cityaqrpc.MapScaleRequest) (*cityaqrpc.MapScaleResponse, error) {
+// CachedResult mocks base method
+func (m *MockCityAQClient) CachedResult(ctx context.Context, in *cityaqrpc.CachedResultRequest, opts ...grpc.CallOption) (*cityaqrpc.CachedResultInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MapScale", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.MapScaleResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CachedResult", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.CachedResultInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MapScale indicates an expected call of MapScale
-func (mr *MockCityAQServerMockRecorder) MapScale(arg0, arg1 interface{}) *gomock.Call {
+// CachedResult indicates an expected call of CachedResult
+func (mr *MockCityAQClientMockRecorder) CachedResult(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapScale", reflect.TypeOf((*MockCityAQServer)(nil).MapScale), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CachedResult", reflect.TypeOf((*MockCityAQClient)(nil).CachedResult), varargs...)
 }
 
-// GriddedPopulation mocks base method
-func (m *MockCityAQServer) GriddedPopulation(arg0 context.Context, arg1 *cityaqrpc.GriddedPopulationRequest) (*cityaqrpc.GriddedPopulationResponse, error) {
+// InvalidateCachedResults mocks base method
+func (m *MockCityAQClient) InvalidateCachedResults(ctx context.Context, in *cityaqrpc.InvalidateCachedResultsRequest, opts ...grpc.CallOption) (*cityaqrpc.InvalidateCachedResultsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GriddedPopulation", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.GriddedPopulationResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateCachedResults", varargs...)
+	ret0, _ := ret[0].(*cityaqrpc.InvalidateCachedResultsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GriddedPopulation indicates an expected call of GriddedPopulation
-func (mr *MockCityAQServerMockRecorder) GriddedPopulation(arg0, arg1 interface{}) *gomock.Call {
+// InvalidateCachedResults indicates an expected call of InvalidateCachedResults
+func (mr *MockCityAQClientMockRecorder) InvalidateCachedResults(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedPopulation", reflect.TypeOf((*MockCityAQServer)(nil).GriddedPopulation), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateCachedResults", reflect.TypeOf((*MockCityAQClient)(nil).InvalidateCachedResults), varargs...)
 }
 
-// ImpactSummary mocks base method
-func (m *MockCityAQServer) ImpactSummary(arg0 context.Context, arg1 *cityaqrpc.ImpactSummaryRequest) (*cityaqrpc.ImpactSummaryResponse, error) {
+// MockCityAQ_StreamGriddedEmissionsClient is a mock of CityAQ_StreamGriddedEmissionsClient interface
+type MockCityAQ_StreamGriddedEmissionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedEmissionsClientMockRecorder
+}
+
+// MockCityAQ_StreamGriddedEmissionsClientMockRecorder is the mock recorder for MockCityAQ_StreamGriddedEmissionsClient
+type MockCityAQ_StreamGriddedEmissionsClientMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedEmissionsClient
+}
+
+// NewMockCityAQ_StreamGriddedEmissionsClient creates a new mock instance
+func NewMockCityAQ_StreamGriddedEmissionsClient(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedEmissionsClient {
+	mock := &MockCityAQ_StreamGriddedEmissionsClient{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedEmissionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedEmissionsClient) EXPECT() *MockCityAQ_StreamGriddedEmissionsClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsClient) Recv() (*cityaqrpc.GriddedEmissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImpactSummary", arg0, arg1)
-	ret0, _ := ret[0].(*cityaqrpc.ImpactSummaryResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*cityaqrpc.GriddedEmissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ImpactSummary indicates an expected call of ImpactSummary
-func (mr *MockCityAQServerMockRecorder) ImpactSummary(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImpactSummary", reflect.TypeOf((*MockCityAQServer)(nil).ImpactSummary), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedEmissionsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedEmissionsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedEmissionsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsClient)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_StreamGriddedConcentrationsClient is a mock of CityAQ_StreamGriddedConcentrationsClient interface
+type MockCityAQ_StreamGriddedConcentrationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder
+}
+
+// MockCityAQ_StreamGriddedConcentrationsClientMockRecorder is the mock recorder for MockCityAQ_StreamGriddedConcentrationsClient
+type MockCityAQ_StreamGriddedConcentrationsClientMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedConcentrationsClient
+}
+
+// NewMockCityAQ_StreamGriddedConcentrationsClient creates a new mock instance
+func NewMockCityAQ_StreamGriddedConcentrationsClient(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedConcentrationsClient {
+	mock := &MockCityAQ_StreamGriddedConcentrationsClient{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedConcentrationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) EXPECT() *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) Recv() (*cityaqrpc.GriddedConcentrationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*cityaqrpc.GriddedConcentrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedConcentrationsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedConcentrationsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedConcentrationsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsClient)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_StreamGriddedPopulationClient is a mock of CityAQ_StreamGriddedPopulationClient interface
+type MockCityAQ_StreamGriddedPopulationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedPopulationClientMockRecorder
+}
+
+// MockCityAQ_StreamGriddedPopulationClientMockRecorder is the mock recorder for MockCityAQ_StreamGriddedPopulationClient
+type MockCityAQ_StreamGriddedPopulationClientMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedPopulationClient
+}
+
+// NewMockCityAQ_StreamGriddedPopulationClient creates a new mock instance
+func NewMockCityAQ_StreamGriddedPopulationClient(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedPopulationClient {
+	mock := &MockCityAQ_StreamGriddedPopulationClient{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedPopulationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedPopulationClient) EXPECT() *MockCityAQ_StreamGriddedPopulationClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationClient) Recv() (*cityaqrpc.GriddedPopulationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*cityaqrpc.GriddedPopulationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedPopulationClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedPopulationClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedPopulationClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationClient)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_JobProgressClient is a mock of CityAQ_JobProgressClient interface
+type MockCityAQ_JobProgressClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_JobProgressClientMockRecorder
+}
+
+// MockCityAQ_JobProgressClientMockRecorder is the mock recorder for MockCityAQ_JobProgressClient
+type MockCityAQ_JobProgressClientMockRecorder struct {
+	mock *MockCityAQ_JobProgressClient
+}
+
+// NewMockCityAQ_JobProgressClient creates a new mock instance
+func NewMockCityAQ_JobProgressClient(ctrl *gomock.Controller) *MockCityAQ_JobProgressClient {
+	mock := &MockCityAQ_JobProgressClient{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_JobProgressClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_JobProgressClient) EXPECT() *MockCityAQ_JobProgressClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockCityAQ_JobProgressClient) Recv() (*cityaqrpc.JobProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*cityaqrpc.JobProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockCityAQ_JobProgressClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockCityAQ_JobProgressClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockCityAQ_JobProgressClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockCityAQ_JobProgressClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockCityAQ_JobProgressClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockCityAQ_JobProgressClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockCityAQ_JobProgressClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockCityAQ_JobProgressClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_JobProgressClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_JobProgressClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_JobProgressClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_JobProgressClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_JobProgressClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_JobProgressClient)(nil).RecvMsg), m)
+}
+
+// MockCityAQServer is a mock of CityAQServer interface
+type MockCityAQServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQServerMockRecorder
+}
+
+// MockCityAQServerMockRecorder is the mock recorder for MockCityAQServer
+type MockCityAQServerMockRecorder struct {
+	mock *MockCityAQServer
+}
+
+// NewMockCityAQServer creates a new mock instance
+func NewMockCityAQServer(ctrl *gomock.Controller) *MockCityAQServer {
+	mock := &MockCityAQServer{ctrl: ctrl}
+	mock.recorder = &MockCityAQServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQServer) EXPECT() *MockCityAQServerMockRecorder {
+	return m.recorder
+}
+
+// Cities mocks base method
+func (m *MockCityAQServer) Cities(arg0 context.Context, arg1 *cityaqrpc.CitiesRequest) (*cityaqrpc.CitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cities", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.CitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cities indicates an expected call of Cities
+func (mr *MockCityAQServerMockRecorder) Cities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cities", reflect.TypeOf((*MockCityAQServer)(nil).Cities), arg0, arg1)
+}
+
+// Options mocks base method
+func (m *MockCityAQServer) Options(arg0 context.Context, arg1 *cityaqrpc.OptionsRequest) (*cityaqrpc.OptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Options", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.OptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Options indicates an expected call of Options
+func (mr *MockCityAQServerMockRecorder) Options(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockCityAQServer)(nil).Options), arg0, arg1)
+}
+
+// CityGeometry mocks base method
+func (m *MockCityAQServer) CityGeometry(arg0 context.Context, arg1 *cityaqrpc.CityGeometryRequest) (*cityaqrpc.CityGeometryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CityGeometry", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.CityGeometryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CityGeometry indicates an expected call of CityGeometry
+func (mr *MockCityAQServerMockRecorder) CityGeometry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CityGeometry", reflect.TypeOf((*MockCityAQServer)(nil).CityGeometry), arg0, arg1)
+}
+
+// GriddedEmissions mocks base method
+func (m *MockCityAQServer) GriddedEmissions(arg0 context.Context, arg1 *cityaqrpc.GriddedEmissionsRequest) (*cityaqrpc.GriddedEmissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GriddedEmissions", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.GriddedEmissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GriddedEmissions indicates an expected call of GriddedEmissions
+func (mr *MockCityAQServerMockRecorder) GriddedEmissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedEmissions", reflect.TypeOf((*MockCityAQServer)(nil).GriddedEmissions), arg0, arg1)
+}
+
+// StreamGriddedEmissions mocks base method
+func (m *MockCityAQServer) StreamGriddedEmissions(arg0 *cityaqrpc.GriddedEmissionsRequest, arg1 cityaqrpc.CityAQ_StreamGriddedEmissionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamGriddedEmissions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamGriddedEmissions indicates an expected call of StreamGriddedEmissions
+func (mr *MockCityAQServerMockRecorder) StreamGriddedEmissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedEmissions", reflect.TypeOf((*MockCityAQServer)(nil).StreamGriddedEmissions), arg0, arg1)
+}
+
+// EmissionsGridBounds mocks base method
+func (m *MockCityAQServer) EmissionsGridBounds(arg0 context.Context, arg1 *cityaqrpc.EmissionsGridBoundsRequest) (*cityaqrpc.EmissionsGridBoundsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmissionsGridBounds", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.EmissionsGridBoundsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmissionsGridBounds indicates an expected call of EmissionsGridBounds
+func (mr *MockCityAQServerMockRecorder) EmissionsGridBounds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmissionsGridBounds", reflect.TypeOf((*MockCityAQServer)(nil).EmissionsGridBounds), arg0, arg1)
+}
+
+// GriddedConcentrations mocks base method
+func (m *MockCityAQServer) GriddedConcentrations(arg0 context.Context, arg1 *cityaqrpc.GriddedConcentrationsRequest) (*cityaqrpc.GriddedConcentrationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GriddedConcentrations", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.GriddedConcentrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GriddedConcentrations indicates an expected call of GriddedConcentrations
+func (mr *MockCityAQServerMockRecorder) GriddedConcentrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedConcentrations", reflect.TypeOf((*MockCityAQServer)(nil).GriddedConcentrations), arg0, arg1)
+}
+
+// StreamGriddedConcentrations mocks base method
+func (m *MockCityAQServer) StreamGriddedConcentrations(arg0 *cityaqrpc.GriddedConcentrationsRequest, arg1 cityaqrpc.CityAQ_StreamGriddedConcentrationsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamGriddedConcentrations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamGriddedConcentrations indicates an expected call of StreamGriddedConcentrations
+func (mr *MockCityAQServerMockRecorder) StreamGriddedConcentrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedConcentrations", reflect.TypeOf((*MockCityAQServer)(nil).StreamGriddedConcentrations), arg0, arg1)
+}
+
+// MapScale mocks base method
+func (m *MockCityAQServer) MapScale(arg0 context.Context, arg1 *cityaqrpc.MapScaleRequest) (*cityaqrpc.MapScaleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapScale", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.MapScaleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapScale indicates an expected call of MapScale
+func (mr *MockCityAQServerMockRecorder) MapScale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapScale", reflect.TypeOf((*MockCityAQServer)(nil).MapScale), arg0, arg1)
+}
+
+// GriddedPopulation mocks base method
+func (m *MockCityAQServer) GriddedPopulation(arg0 context.Context, arg1 *cityaqrpc.GriddedPopulationRequest) (*cityaqrpc.GriddedPopulationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GriddedPopulation", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.GriddedPopulationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GriddedPopulation indicates an expected call of GriddedPopulation
+func (mr *MockCityAQServerMockRecorder) GriddedPopulation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GriddedPopulation", reflect.TypeOf((*MockCityAQServer)(nil).GriddedPopulation), arg0, arg1)
+}
+
+// StreamGriddedPopulation mocks base method
+func (m *MockCityAQServer) StreamGriddedPopulation(arg0 *cityaqrpc.GriddedPopulationRequest, arg1 cityaqrpc.CityAQ_StreamGriddedPopulationServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamGriddedPopulation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamGriddedPopulation indicates an expected call of StreamGriddedPopulation
+func (mr *MockCityAQServerMockRecorder) StreamGriddedPopulation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGriddedPopulation", reflect.TypeOf((*MockCityAQServer)(nil).StreamGriddedPopulation), arg0, arg1)
+}
+
+// ImpactSummary mocks base method
+func (m *MockCityAQServer) ImpactSummary(arg0 context.Context, arg1 *cityaqrpc.ImpactSummaryRequest) (*cityaqrpc.ImpactSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImpactSummary", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.ImpactSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImpactSummary indicates an expected call of ImpactSummary
+func (mr *MockCityAQServerMockRecorder) ImpactSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImpactSummary", reflect.TypeOf((*MockCityAQServer)(nil).ImpactSummary), arg0, arg1)
+}
+
+// Damages mocks base method
+func (m *MockCityAQServer) Damages(arg0 context.Context, arg1 *cityaqrpc.DamagesRequest) (*cityaqrpc.DamagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Damages", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.DamagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Damages indicates an expected call of Damages
+func (mr *MockCityAQServerMockRecorder) Damages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Damages", reflect.TypeOf((*MockCityAQServer)(nil).Damages), arg0, arg1)
+}
+
+// JobProgress mocks base method
+func (m *MockCityAQServer) JobProgress(arg0 *cityaqrpc.JobProgressRequest, arg1 cityaqrpc.CityAQ_JobProgressServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobProgress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JobProgress indicates an expected call of JobProgress
+func (mr *MockCityAQServerMockRecorder) JobProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobProgress", reflect.TypeOf((*MockCityAQServer)(nil).JobProgress), arg0, arg1)
+}
+
+// SubmitConcentrationJob mocks base method
+func (m *MockCityAQServer) SubmitConcentrationJob(arg0 context.Context, arg1 *cityaqrpc.SubmitConcentrationJobRequest) (*cityaqrpc.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitConcentrationJob", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitConcentrationJob indicates an expected call of SubmitConcentrationJob
+func (mr *MockCityAQServerMockRecorder) SubmitConcentrationJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitConcentrationJob", reflect.TypeOf((*MockCityAQServer)(nil).SubmitConcentrationJob), arg0, arg1)
+}
+
+// GetJob mocks base method
+func (m *MockCityAQServer) GetJob(arg0 context.Context, arg1 *cityaqrpc.GetJobRequest) (*cityaqrpc.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob
+func (mr *MockCityAQServerMockRecorder) GetJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockCityAQServer)(nil).GetJob), arg0, arg1)
+}
+
+// CancelJob mocks base method
+func (m *MockCityAQServer) CancelJob(arg0 context.Context, arg1 *cityaqrpc.CancelJobRequest) (*cityaqrpc.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob
+func (mr *MockCityAQServerMockRecorder) CancelJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockCityAQServer)(nil).CancelJob), arg0, arg1)
+}
+
+// ListJobs mocks base method
+func (m *MockCityAQServer) ListJobs(arg0 context.Context, arg1 *cityaqrpc.ListJobsRequest) (*cityaqrpc.ListJobsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.ListJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockCityAQServerMockRecorder) ListJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockCityAQServer)(nil).ListJobs), arg0, arg1)
+}
+
+// ListCachedResults mocks base method
+func (m *MockCityAQServer) ListCachedResults(arg0 context.Context, arg1 *cityaqrpc.ListCachedResultsRequest) (*cityaqrpc.ListCachedResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCachedResults", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.ListCachedResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCachedResults indicates an expected call of ListCachedResults
+func (mr *MockCityAQServerMockRecorder) ListCachedResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCachedResults", reflect.TypeOf((*MockCityAQServer)(nil).ListCachedResults), arg0, arg1)
+}
+
+// CachedResult mocks base method
+func (m *MockCityAQServer) CachedResult(arg0 context.Context, arg1 *cityaqrpc.CachedResultRequest) (*cityaqrpc.CachedResultInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CachedResult", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.CachedResultInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CachedResult indicates an expected call of CachedResult
+func (mr *MockCityAQServerMockRecorder) CachedResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CachedResult", reflect.TypeOf((*MockCityAQServer)(nil).CachedResult), arg0, arg1)
+}
+
+// InvalidateCachedResults mocks base method
+func (m *MockCityAQServer) InvalidateCachedResults(arg0 context.Context, arg1 *cityaqrpc.InvalidateCachedResultsRequest) (*cityaqrpc.InvalidateCachedResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateCachedResults", arg0, arg1)
+	ret0, _ := ret[0].(*cityaqrpc.InvalidateCachedResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateCachedResults indicates an expected call of InvalidateCachedResults
+func (mr *MockCityAQServerMockRecorder) InvalidateCachedResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateCachedResults", reflect.TypeOf((*MockCityAQServer)(nil).InvalidateCachedResults), arg0, arg1)
+}
+
+// MockCityAQ_StreamGriddedEmissionsServer is a mock of CityAQ_StreamGriddedEmissionsServer interface
+type MockCityAQ_StreamGriddedEmissionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedEmissionsServerMockRecorder
+}
+
+// MockCityAQ_StreamGriddedEmissionsServerMockRecorder is the mock recorder for MockCityAQ_StreamGriddedEmissionsServer
+type MockCityAQ_StreamGriddedEmissionsServerMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedEmissionsServer
+}
+
+// NewMockCityAQ_StreamGriddedEmissionsServer creates a new mock instance
+func NewMockCityAQ_StreamGriddedEmissionsServer(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedEmissionsServer {
+	mock := &MockCityAQ_StreamGriddedEmissionsServer{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedEmissionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedEmissionsServer) EXPECT() *MockCityAQ_StreamGriddedEmissionsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsServer) Send(arg0 *cityaqrpc.GriddedEmissionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedEmissionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedEmissionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedEmissionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedEmissionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedEmissionsServer)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_StreamGriddedConcentrationsServer is a mock of CityAQ_StreamGriddedConcentrationsServer interface
+type MockCityAQ_StreamGriddedConcentrationsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder
+}
+
+// MockCityAQ_StreamGriddedConcentrationsServerMockRecorder is the mock recorder for MockCityAQ_StreamGriddedConcentrationsServer
+type MockCityAQ_StreamGriddedConcentrationsServerMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedConcentrationsServer
+}
+
+// NewMockCityAQ_StreamGriddedConcentrationsServer creates a new mock instance
+func NewMockCityAQ_StreamGriddedConcentrationsServer(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedConcentrationsServer {
+	mock := &MockCityAQ_StreamGriddedConcentrationsServer{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedConcentrationsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) EXPECT() *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) Send(arg0 *cityaqrpc.GriddedConcentrationsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedConcentrationsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedConcentrationsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedConcentrationsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedConcentrationsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedConcentrationsServer)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_StreamGriddedPopulationServer is a mock of CityAQ_StreamGriddedPopulationServer interface
+type MockCityAQ_StreamGriddedPopulationServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_StreamGriddedPopulationServerMockRecorder
+}
+
+// MockCityAQ_StreamGriddedPopulationServerMockRecorder is the mock recorder for MockCityAQ_StreamGriddedPopulationServer
+type MockCityAQ_StreamGriddedPopulationServerMockRecorder struct {
+	mock *MockCityAQ_StreamGriddedPopulationServer
+}
+
+// NewMockCityAQ_StreamGriddedPopulationServer creates a new mock instance
+func NewMockCityAQ_StreamGriddedPopulationServer(ctrl *gomock.Controller) *MockCityAQ_StreamGriddedPopulationServer {
+	mock := &MockCityAQ_StreamGriddedPopulationServer{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_StreamGriddedPopulationServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_StreamGriddedPopulationServer) EXPECT() *MockCityAQ_StreamGriddedPopulationServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationServer) Send(arg0 *cityaqrpc.GriddedPopulationResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockCityAQ_StreamGriddedPopulationServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedPopulationServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_StreamGriddedPopulationServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_StreamGriddedPopulationServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_StreamGriddedPopulationServer)(nil).RecvMsg), m)
+}
+
+// MockCityAQ_JobProgressServer is a mock of CityAQ_JobProgressServer interface
+type MockCityAQ_JobProgressServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityAQ_JobProgressServerMockRecorder
+}
+
+// MockCityAQ_JobProgressServerMockRecorder is the mock recorder for MockCityAQ_JobProgressServer
+type MockCityAQ_JobProgressServerMockRecorder struct {
+	mock *MockCityAQ_JobProgressServer
+}
+
+// NewMockCityAQ_JobProgressServer creates a new mock instance
+func NewMockCityAQ_JobProgressServer(ctrl *gomock.Controller) *MockCityAQ_JobProgressServer {
+	mock := &MockCityAQ_JobProgressServer{ctrl: ctrl}
+	mock.recorder = &MockCityAQ_JobProgressServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCityAQ_JobProgressServer) EXPECT() *MockCityAQ_JobProgressServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockCityAQ_JobProgressServer) Send(arg0 *cityaqrpc.JobProgressResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockCityAQ_JobProgressServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockCityAQ_JobProgressServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockCityAQ_JobProgressServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockCityAQ_JobProgressServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockCityAQ_JobProgressServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockCityAQ_JobProgressServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockCityAQ_JobProgressServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockCityAQ_JobProgressServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockCityAQ_JobProgressServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockCityAQ_JobProgressServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockCityAQ_JobProgressServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockCityAQ_JobProgressServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockCityAQ_JobProgressServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCityAQ_JobProgressServer)(nil).RecvMsg), m)
 }
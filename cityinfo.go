@@ -0,0 +1,103 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/geom"
+	"github.com/ctessum/geom/proj"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cityInfo returns information about the city in cf. The result is
+// reused until the boundary of the city changes.
+func (c *CityAQ) cityInfo(cf cityFile) (*rpc.City, error) {
+	key := cf.name + "_" + cf.version
+	c.cityInfoMu.Lock()
+	info, ok := c.cityInfos[key]
+	c.cityInfoMu.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	props, err := geojsonProperties(cf.path)
+	if err != nil {
+		return nil, err
+	}
+	g, err := geojsonFileGeometry(cf.path)
+	if err != nil {
+		return nil, err
+	}
+	area, err := polygonArea(g)
+	if err != nil {
+		return nil, err
+	}
+	b := g.Bounds()
+	centroid := g.Centroid()
+	info = &rpc.City{
+		ID:           cf.id,
+		Name:         cf.name,
+		DisplayNames: displayNames(props),
+		Centroid:     &rpc.Point{X: centroid.X, Y: centroid.Y},
+		Bounds: &rpc.BoundingBox{
+			Min: &rpc.Point{X: b.Min.X, Y: b.Min.Y},
+			Max: &rpc.Point{X: b.Max.X, Y: b.Max.Y},
+		},
+		Area:       area,
+		Properties: make(map[string]string),
+	}
+	for k, v := range props {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("cityaq: city %s property %s: %v", cf.name, k, err)
+		}
+		info.Properties[k] = string(j)
+	}
+
+	ctry, err := c.country(cf.name)
+	switch {
+	case err == nil:
+		info.Country = ctry.Name
+	case status.Code(err) == codes.FailedPrecondition:
+		// The city isn't in any country.
+	default:
+		// The countries may be loaded successfully later, so don't
+		// reuse the result.
+		return info, nil
+	}
+
+	c.cityInfoMu.Lock()
+	if c.cityInfos == nil {
+		c.cityInfos = make(map[string]*rpc.City)
+	}
+	c.cityInfos[key] = info
+	c.cityInfoMu.Unlock()
+	return info, nil
+}
+
+// polygonArea returns the area of g, which is in longitude-latitude
+// coordinates, in km². It uses an Albers equal-area projection fitted
+// to the bounds of g.
+func polygonArea(g geom.Polygon) (float64, error) {
+	b := g.Bounds()
+	src, err := proj.Parse("+proj=longlat")
+	if err != nil {
+		return 0, err
+	}
+	dst, err := proj.Parse(fmt.Sprintf("+proj=aea +lat_1=%g +lat_2=%g +lat_0=%g +lon_0=%g +x_0=0 +y_0=0 +ellps=WGS84 +datum=WGS84 +units=m",
+		b.Min.Y, b.Max.Y, (b.Min.Y+b.Max.Y)/2, (b.Min.X+b.Max.X)/2))
+	if err != nil {
+		return 0, err
+	}
+	t, err := src.NewTransform(dst)
+	if err != nil {
+		return 0, err
+	}
+	gt, err := g.Transform(t)
+	if err != nil {
+		return 0, err
+	}
+	return gt.(geom.Polygon).Area() / 1e6, nil
+}
@@ -0,0 +1,45 @@
+package cityaq
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+func TestCityAQ_cityInfo(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+	}
+	c.SpatialConfig.SrgShapefileDirectory = "testdata"
+
+	cities, err := c.Cities(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := cities.Cities[0]
+	if info.ID != "accra_jurisdiction" {
+		t.Errorf("ID: %s", info.ID)
+	}
+	if info.Name != "Accra Metropolitan" {
+		t.Errorf("Name: %s", info.Name)
+	}
+	if info.Country != "Ghana" {
+		t.Errorf("Country: %s", info.Country)
+	}
+	if info.Properties["name"] == "" {
+		t.Errorf("missing name property: %v", info.Properties)
+	}
+	b, p := info.Bounds, info.Centroid
+	if p.X < b.Min.X || p.X > b.Max.X || p.Y < b.Min.Y || p.Y > b.Max.Y {
+		t.Errorf("centroid %v is outside of bounds %v", p, b)
+	}
+	// The Accra Metropolitan district has an area of about 140 km².
+	if info.Area < 100 || info.Area > 250 {
+		t.Errorf("Area: %g km²", info.Area)
+	}
+
+	if _, err := c.CityGeometry(context.Background(), &rpc.CityGeometryRequest{CityName: info.ID}); err != nil {
+		t.Errorf("looking up by ID: %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+package cityaq
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// cityReloadDelay is how long to wait after a change in CityGeomDir
+// before reloading it, so that several changes made together, such
+// as copying a set of files, cause only one reload.
+const cityReloadDelay = time.Second
+
+// cityRegistry holds the locations of the GeoJSON files in a directory
+// that contain the boundaries of cities. It is safe for concurrent use.
+type cityRegistry struct {
+	dir string
+
+	// name returns the name of the city in the given file.
+	name func(path string) (string, error)
+
+	// loadMu makes sure that only one load happens at a time.
+	loadMu sync.Mutex
+
+	mu     sync.RWMutex
+	cities map[string]cityFile
+	names  []string          // in the order they were found.
+	ids    map[string]string // city names by ID.
+
+	watcher *fsnotify.Watcher
+}
+
+// cityFile is a file that contains the boundary of a city.
+type cityFile struct {
+	name, path string
+
+	// id is the path of the file relative to the directory of the
+	// registry, without the extension.
+	id string
+
+	// version identifies the contents of the file, so that results
+	// calculated from an earlier version of the boundary are not
+	// reused.
+	version string
+}
+
+// newCityRegistry returns a registry of the cities in dir.
+func newCityRegistry(dir string, name func(path string) (string, error)) (*cityRegistry, error) {
+	r := &cityRegistry{dir: dir, name: name}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads the city files in the directory of the receiver. Files that
+// can't be read are logged and skipped, as are files with the same city
+// name as a file that was found earlier. If the directory can't be read,
+// the previously loaded cities are kept.
+func (r *cityRegistry) load() error {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+	cities := make(map[string]cityFile)
+	var names []string
+	ids := make(map[string]string)
+	err := filepath.Walk(r.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".geojson" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Printf("cityaq: skipping city file: %v", err)
+			return nil
+		}
+		name, err := r.name(path)
+		if err != nil {
+			log.Printf("cityaq: skipping city file %s: %v", path, err)
+			return nil
+		}
+		if f, ok := cities[name]; ok {
+			log.Printf("cityaq: skipping city file %s: city %s is already in %s", path, name, f.path)
+			return nil
+		}
+		names = append(names, name)
+		rel, err := filepath.Rel(r.dir, path)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(b)
+		f := cityFile{
+			name:    name,
+			path:    path,
+			id:      filepath.ToSlash(strings.TrimSuffix(rel, filepath.Ext(rel))),
+			version: hex.EncodeToString(sum[:8]),
+		}
+		cities[name] = f
+		ids[f.id] = name
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	old := r.cities
+	r.cities, r.names, r.ids = cities, names, ids
+	r.mu.Unlock()
+
+	for name, f := range old {
+		if nf, ok := cities[name]; !ok {
+			log.Printf("cityaq: city %s removed", name)
+		} else if nf.version != f.version {
+			log.Printf("cityaq: boundary of city %s changed", name)
+		}
+	}
+	return nil
+}
+
+// list returns the files of the cities, in the order they were found.
+func (r *cityRegistry) list() []cityFile {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	files := make([]cityFile, len(r.names))
+	for i, name := range r.names {
+		files[i] = r.cities[name]
+	}
+	return files
+}
+
+// get returns the file for the city with the given name or ID.
+func (r *cityRegistry) get(nameOrID string) (cityFile, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if f, ok := r.cities[nameOrID]; ok {
+		return f, true
+	}
+	f, ok := r.cities[r.ids[nameOrID]]
+	return f, ok
+}
+
+// watching returns whether the receiver is reloaded automatically
+// when its directory changes.
+func (r *cityRegistry) watching() bool {
+	return r.watcher != nil
+}
+
+// watch starts reloading the receiver when files in its directory
+// change.
+func (r *cityRegistry) watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// fsnotify doesn't watch subdirectories, so add them separately.
+	err = filepath.Walk(r.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return w.Add(path)
+		}
+		return nil
+	})
+	if err != nil {
+		w.Close()
+		return err
+	}
+	r.watcher = w
+	go r.handleEvents(w)
+	return nil
+}
+
+// handleEvents reloads the receiver after files change, until w is
+// closed.
+func (r *cityRegistry) handleEvents(w *fsnotify.Watcher) {
+	var timer *time.Timer
+	reload := func() {
+		if err := r.load(); err != nil {
+			log.Printf("cityaq: reloading cities: %v", err)
+		}
+	}
+	for {
+		select {
+		case ev, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if ev.Op == fsnotify.Chmod {
+				continue
+			}
+			if ev.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					if err := w.Add(ev.Name); err != nil {
+						log.Printf("cityaq: watching %s: %v", ev.Name, err)
+					}
+				}
+			}
+			if timer == nil {
+				timer = time.AfterFunc(cityReloadDelay, reload)
+			} else {
+				timer.Reset(cityReloadDelay)
+			}
+		case err, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("cityaq: watching %s: %v", r.dir, err)
+		}
+	}
+}
+
+// close stops watching the directory of the receiver.
+func (r *cityRegistry) close() error {
+	if r.watcher == nil {
+		return nil
+	}
+	return r.watcher.Close()
+}
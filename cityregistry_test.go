@@ -0,0 +1,153 @@
+package cityaq
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+// waitFor calls f until it returns true or a timeout is reached.
+func waitFor(t *testing.T, what string, f func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		if f() {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+	b, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(dst, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCityRegistry(t *testing.T) {
+	const karachi = "ڪراچي Karachi"
+	dir, err := ioutil.TempDir("", "cityaq_cities")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	copyFile(t, "testdata/cities/accra_jurisdiction.geojson", filepath.Join(dir, "accra.geojson"))
+
+	c := &CityAQ{CityGeomDir: dir}
+	ctx := context.Background()
+	cities := func() []string {
+		r, err := c.Cities(ctx, &rpc.CitiesRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r.Names
+	}
+	if have, want := cities(), []string{"Accra Metropolitan"}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("have %v, want %v", have, want)
+	}
+	defer c.cities.close()
+	if !c.cities.watching() {
+		t.Skip("can't watch for changes")
+	}
+	accra, err := c.cityFile("Accra Metropolitan")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("add", func(t *testing.T) {
+		copyFile(t, "testdata/cities/karachi_jurisdiction.geojson", filepath.Join(dir, "karachi.geojson"))
+		waitFor(t, "new city", func() bool { return len(cities()) == 2 })
+		if _, err := c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName: karachi}); err != nil {
+			t.Error(err)
+		}
+		if _, err := c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName: "karachi"}); err != nil {
+			t.Errorf("looking up by ID: %v", err)
+		}
+	})
+
+	t.Run("change", func(t *testing.T) {
+		b, err := ioutil.ReadFile("testdata/cities/accra_jurisdiction.geojson")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b = []byte(strings.Replace(string(b), "5.", "5.0", 1))
+		if err := ioutil.WriteFile(filepath.Join(dir, "accra.geojson"), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		waitFor(t, "changed city", func() bool {
+			f, err := c.cityFile("Accra Metropolitan")
+			return err == nil && f.version != accra.version
+		})
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		if err := os.Remove(filepath.Join(dir, "karachi.geojson")); err != nil {
+			t.Fatal(err)
+		}
+		waitFor(t, "removed city", func() bool { return len(cities()) == 1 })
+		if _, err := c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName: karachi}); err == nil {
+			t.Error("removed city should not be found")
+		}
+	})
+}
+
+func TestCityRegistry_badFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_cities")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	copyFile(t, "testdata/cities/accra_jurisdiction.geojson", filepath.Join(dir, "accra.geojson"))
+	copyFile(t, "testdata/cities/accra_jurisdiction.geojson", filepath.Join(dir, "accra_copy.geojson"))
+	if err := ioutil.WriteFile(filepath.Join(dir, "bad.geojson"), []byte(`{"type": "FeatureCollection"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := newCityRegistry(dir, func(path string) (string, error) {
+		return new(CityAQ).geojsonName(path, "")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := r.list()
+	if len(files) != 1 || files[0].id != "accra" {
+		t.Errorf("have %+v, want only accra", files)
+	}
+}
+
+func TestCityRegistry_concurrent(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	if err := c.loadCities(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.cities.close()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := c.cities.load(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := c.CityGeometry(context.Background(), &rpc.CityGeometryRequest{CityName: "Accra Metropolitan"}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -44,6 +44,10 @@ func main() {
 		},
 		CacheLoc:        "file://" + cache,
 		InMAPConfigFile: "testdata/inmap_config.toml",
+		// If no income file is given, Damages requests that
+		// adjust the VSL must include the country incomes.
+		CountryIncomeFile: os.Getenv("CITYAQ_COUNTRY_INCOME_FILE"),
+		AdminToken:        os.Getenv("CITYAQ_ADMIN_TOKEN"),
 	}
 
 	srv := cityaq.NewGRPCServer(c)
@@ -3,6 +3,8 @@ package cityaq
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 	"io/ioutil"
@@ -12,7 +14,6 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
-	"time"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
 
@@ -20,137 +21,289 @@ import (
 	"github.com/ctessum/geom/encoding/shp"
 	"github.com/ctessum/requestcache/v3"
 	"github.com/spatialmodel/inmap"
-	"github.com/spatialmodel/inmap/cloud"
 	"github.com/spatialmodel/inmap/cloud/cloudrpc"
 	"github.com/spatialmodel/inmap/inmaputil"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
 )
 
 // GriddedConcentrations returns PM2.5 concentrations calculated by the InMAP
-// air quality model.
+// air quality model, for the emissions rate specified by req.EmissionAmount
+// and req.EmissionUnit.
 func (c *CityAQ) GriddedConcentrations(ctx context.Context, req *rpc.GriddedConcentrationsRequest) (*rpc.GriddedConcentrationsResponse, error) {
-	var err error
-	c.cloudSetupOnce.Do(func() {
-		err = c.cloudSetup()
-	})
+	o, err := c.griddedConcentrations(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	c.setupCache()
+	if req.Encoding == rpc.GridEncoding_CompactEncoding {
+		o.Grid, o.Values = compactGrid(o.Polygons), float32s(o.Concentrations)
+		o.PrimaryPM25Values, o.PNH4Values = float32s(o.PrimaryPM25), float32s(o.PNH4)
+		o.PNO3Values, o.PSO4Values = float32s(o.PNO3), float32s(o.PSO4)
+		o.SOAValues = float32s(o.SOA)
+		o.BaselineTotalPM25Values = float32s(o.BaselineTotalPM25)
+		o.Polygons, o.Concentrations = nil, nil
+		o.PrimaryPM25, o.PNH4, o.PNO3, o.PSO4, o.SOA = nil, nil, nil, nil, nil
+		o.BaselineTotalPM25 = nil
+	}
+	return o, nil
+}
 
-	job := &concentrationJob{
-		c:          c,
-		CityName:   req.CityName,
-		SourceType: req.SourceType,
+func (c *CityAQ) griddedConcentrations(ctx context.Context, req *rpc.GriddedConcentrationsRequest) (*rpc.GriddedConcentrationsResponse, error) {
+	var scenario map[rpc.Emission]float64
+	if req.Scenario != nil {
+		var err error
+		scenario, err = scenarioScales(req.Scenario)
+		if err != nil {
+			return nil, err
+		}
+	}
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, scenario)
+	if err != nil {
+		return nil, err
 	}
 
-	inmapReq := c.cache.NewRequest(ctx, job)
-	var result inmapResult
-	if err := inmapReq.Result(&result); err != nil {
+	result, err := c.concentrationResult(ctx, job)
+	if err != nil {
 		return nil, err
 	}
 
 	o := &rpc.GriddedConcentrationsResponse{
-		Polygons: polygonsToRPC(result.Grid),
+		Polygons:          polygonsToRPC(result.Grid),
+		BaselineTotalPM25: result.BaselineTotalPM25,
+	}
+	if req.Scenario != nil {
+		// The emissions of each pollutant were scaled before the
+		// model was run.
+		o.PrimaryPM25 = result.PrimaryPM25
+		o.PNH4 = result.PNH4
+		o.PNO3 = result.PNO3
+		o.PSO4 = result.PSO4
+		o.SOA = result.SOA
+		o.Concentrations = result.totalPM25(nil)
+		return o, nil
+	}
+
+	// The model is run for 1 kt/yr of emissions of each pollutant, so
+	// we scale the results to match the requested emissions rate.
+	scale, err := emissionsScale(req.EmissionAmount, req.EmissionUnit)
+	if err != nil {
+		return nil, err
 	}
-	switch req.Emission {
-	case rpc.Emission_PM2_5:
-		o.Concentrations = result.PrimaryPM25
-	case rpc.Emission_NH3:
-		o.Concentrations = result.PNH4
-	case rpc.Emission_NOx:
-		o.Concentrations = result.PNO3
-	case rpc.Emission_SOx:
-		o.Concentrations = result.PSO4
-	case rpc.Emission_VOC:
-		o.Concentrations = result.SOA
-	default:
-		return nil, fmt.Errorf("cityaq: invalid emission type %s", req.Emission)
+	if req.TotalPM25 {
+		o.Concentrations = result.totalPM25(uniformScales(scale))
+		return o, nil
 	}
+	species, ok := result.species(req.Emission)
+	if !ok {
+		return nil, invalidArgument("Emission", "cityaq: invalid emission type %s", req.Emission)
+	}
+	o.Concentrations = scaled(species, scale)
 	return o, nil
 }
 
-// GriddedPopulation returns population counts on the same grid as
-// the gridded concentrations.
+// precursors are the emitted pollutants that each form a PM2.5 species.
+var precursors = []rpc.Emission{rpc.Emission_PM2_5, rpc.Emission_NH3, rpc.Emission_NOx, rpc.Emission_SOx, rpc.Emission_VOC}
+
+// uniformScales returns emissions scales, as used for scenarios, in
+// which each precursor is emitted at the same rate relative to 1 kt/yr.
+func uniformScales(scale float64) map[rpc.Emission]float64 {
+	o := make(map[rpc.Emission]float64)
+	for _, p := range precursors {
+		o[p] = scale
+	}
+	return o
+}
+
+// totalEmissions returns the total emissions rate in kg/year for the
+// given emissions scales relative to 1 kt/yr.
+func totalEmissions(scales map[rpc.Emission]float64) float64 {
+	var o float64
+	for _, s := range scales {
+		o += s * 1.0e6
+	}
+	return o
+}
+
+// scaled returns the values of v multiplied by scale.
+func scaled(v []float64, scale float64) []float64 {
+	if scale == 1 {
+		return v
+	}
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = x * scale
+	}
+	return o
+}
+
+// GriddedPopulation returns population counts and all-cause mortality
+// rates on the same grid as the gridded concentrations.
 func (c *CityAQ) GriddedPopulation(ctx context.Context, req *rpc.GriddedPopulationRequest) (*rpc.GriddedPopulationResponse, error) {
-	var err error
-	c.cloudSetupOnce.Do(func() {
-		err = c.cloudSetup()
-	})
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, nil)
 	if err != nil {
 		return nil, err
 	}
-	c.setupCache()
-
-	job := &concentrationJob{
-		c:          c,
-		CityName:   req.CityName,
-		SourceType: req.SourceType,
-	}
 
-	inmapReq := c.cache.NewRequest(ctx, job)
-	var result inmapResult
-	if err := inmapReq.Result(&result); err != nil {
+	result, err := c.concentrationResult(ctx, job)
+	if err != nil {
 		return nil, err
 	}
 
 	o := &rpc.GriddedPopulationResponse{
-		Polygons:   polygonsToRPC(result.Grid),
-		Population: result.Population,
+		Polygons:      polygonsToRPC(result.Grid),
+		Population:    result.Population,
+		MortalityRate: result.MortalityRate,
+	}
+	if req.Encoding == rpc.GridEncoding_CompactEncoding {
+		o.Grid, o.Values = compactGrid(o.Polygons), float32s(o.Population)
+		o.MortalityRateValues = float32s(o.MortalityRate)
+		o.Polygons, o.Population, o.MortalityRate = nil, nil, nil
 	}
 	return o, nil
 }
 
-func (c *CityAQ) cloudSetup() error {
-	cfg := inmaputil.InitializeConfig()
+type concentrationJob struct {
+	c          *CityAQ
+	CityName   string
+	CityID     string
+	SourceType string
 
-	if os.ExpandEnv("${KUBERNETES_SERVICE_HOST}") == "" {
-		log.Println("NOT IN KUBERNETES CLUSTER")
-		var err error
-		c.inmapClient, err = cloud.NewFakeClient(nil, func(b []byte, err error) {
-			fmt.Println(string(b))
-			if err != nil {
-				fmt.Println("ERROR", err)
-			}
-		}, c.CacheLoc, cfg.Root, cfg.Viper, cfg.InputFiles(), cfg.OutputFiles())
+	// Scenario holds the emissions rate of each pollutant relative to
+	// 1 kt/yr. If it is nil, 1 kt/yr of each pollutant is emitted, and
+	// the concentrations resulting from each pollutant can be read from
+	// the corresponding PM2.5 species.
+	Scenario map[rpc.Emission]float64
+
+	key string
+}
+
+// scenarioScales returns the emissions rate of each pollutant in s
+// relative to 1 kt/yr.
+func scenarioScales(s *rpc.EmissionScenario) (map[rpc.Emission]float64, error) {
+	if len(s.Emissions) == 0 {
+		return nil, invalidArgument("Scenario.Emissions", "cityaq: emissions scenario has no emissions")
+	}
+	o := make(map[rpc.Emission]float64)
+	for _, e := range s.Emissions {
+		if _, ok := rpc.Emission_name[int32(e.Emission)]; !ok || e.Emission == rpc.Emission_UNKNOWN_EMISSION {
+			return nil, invalidArgument("Scenario.Emissions.Emission", "cityaq: invalid emission type %s in scenario", e.Emission)
+		}
+		if _, ok := o[e.Emission]; ok {
+			return nil, invalidArgument("Scenario.Emissions.Emission", "cityaq: emission type %s specified more than once in scenario", e.Emission)
+		}
+		if e.Amount == 0 {
+			o[e.Emission] = 0
+			continue
+		}
+		scale, err := emissionsScale(e.Amount, e.Unit)
 		if err != nil {
-			return fmt.Errorf("failed to initialize fake InMAP server: %w", err)
+			return nil, err
 		}
-		return nil
+		o[e.Emission] = scale
 	}
+	return o, nil
+}
 
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		return fmt.Errorf("failed to load in-cluster Kubernetes configuration: %w", err)
+// scenarioKey returns a string that uniquely identifies the receiver's
+// emissions scenario, or an empty string if there is no scenario.
+func (j *concentrationJob) scenarioKey() string {
+	if j.Scenario == nil {
+		return ""
 	}
-	clientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return fmt.Errorf("failed to initialize Kubernetes: %w", err)
+	h := sha256.New()
+	for i := int32(1); i < int32(len(rpc.Emission_name)); i++ {
+		fmt.Fprintf(h, "%s:%g;", rpc.Emission(i), j.Scenario[rpc.Emission(i)])
 	}
+	return fmt.Sprintf("_scenario%x", h.Sum(nil)[:8])
+}
 
-	c.inmapClient, err = cloud.NewClient(clientset, cfg.Root, cfg.Viper, c.CacheLoc, cfg.InputFiles(), cfg.OutputFiles())
-	if err != nil {
-		return fmt.Errorf("failed to initialize InMAP server: %w", err)
-	}
+var alphanum *regexp.Regexp
 
-	return nil
+func init() {
+	alphanum = regexp.MustCompile("[^a-z0-9]+")
 }
 
-type concentrationJob struct {
-	c          *CityAQ
-	CityName   string
-	SourceType string
+// keyVersion is incremented when the method used to create cache keys
+// or the format of cached results changes.
+const keyVersion = 2
+
+// newConcentrationJob returns a concentration job for the given city,
+// source type, and emissions scenario, which may be nil.
+func (c *CityAQ) newConcentrationJob(cityName, sourceType string, scenario map[rpc.Emission]float64) (*concentrationJob, error) {
+	// Refer to the city by name in case it was given by ID, so
+	// that both give the same job.
+	cf, err := c.cityFile(cityName)
+	if err != nil {
+		return nil, err
+	}
+	j := &concentrationJob{
+		c:          c,
+		CityName:   cf.name,
+		CityID:     cf.id,
+		SourceType: sourceType,
+		Scenario:   scenario,
+	}
+	j.key, err = j.hashKey()
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
 }
 
-var alphanum *regexp.Regexp
+// Key returns a unique identifier for the receiver.
+func (j *concentrationJob) Key() string { return j.key }
 
-func init() {
-	alphanum = regexp.MustCompile("[^a-z0-9]+")
+// hashKey returns a key that changes whenever any of the inputs to the
+// receiver change: the city boundary, source type, surrogate
+// specifications, emissions scenario, InMAP configuration, or InMAP
+// version. The key only contains lowercase letters and numbers so it
+// can be used as the name of a cloud job.
+func (j *concentrationJob) hashKey() (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "cityaq key version %d\ninmap version %s\n", keyVersion, inmap.Version)
+
+	poly, err := j.c.geojsonGeometry(j.CityName)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range poly {
+		for _, p := range path {
+			if err := binary.Write(h, binary.LittleEndian, [2]float64{p.X, p.Y}); err != nil {
+				return "", err
+			}
+		}
+		fmt.Fprint(h, ";")
+	}
+	fmt.Fprintf(h, "\nsource type %s\n", j.SourceType)
+
+	for _, f := range []string{j.c.SrgSpecOSM, j.c.SrgSpecSMOKE, j.c.InMAPConfigFile} {
+		if f == "" {
+			continue
+		}
+		b, err := ioutil.ReadFile(os.ExpandEnv(f))
+		if err != nil {
+			return "", fmt.Errorf("cityaq: creating job key: %w", err)
+		}
+		h.Write(b)
+	}
+	fmt.Fprintf(h, "\nscenario %s\n", j.scenarioKey())
+
+	prefix := "concentration"
+	if j.c.Backend == SRBackend {
+		// SR matrix results are approximations, so they should not
+		// be mixed with full model results.
+		prefix = "srconcentration"
+	}
+	return fmt.Sprintf("%s%x", prefix, h.Sum(nil)[:12]), nil
 }
 
-func (j *concentrationJob) Key() string {
-	k := fmt.Sprintf("concentration_%s_%s", j.CityName, j.SourceType)
+// legacyKey returns the key that was used for the receiver before
+// keyVersion 2, which only depends on the city name, source type,
+// and scenario.
+func (j *concentrationJob) legacyKey() string {
+	prefix := "concentration"
+	if j.c.Backend == SRBackend {
+		prefix = "srconcentration"
+	}
+	k := fmt.Sprintf("%s_%s_%s%s", prefix, j.CityName, j.SourceType, j.scenarioKey())
 	// remove invalid characters
 	k = alphanum.ReplaceAllString(strings.ToLower(k), "")
 	// shorten
@@ -161,11 +314,54 @@ func (j *concentrationJob) Key() string {
 	return k
 }
 
+// legacyJob retrieves a result stored under a legacy key.
+type legacyJob string
+
+func (j legacyJob) Key() string { return string(j) }
+
+func (j legacyJob) Run(context.Context, requestcache.Result) error {
+	return fmt.Errorf("cityaq: no cached result with legacy key %s", string(j))
+}
+
 func (j *concentrationJob) Run(ctx context.Context, result requestcache.Result) error {
+	if j.c.legacyCache != nil {
+		// Reuse a result that was cached before keyVersion 2, if there
+		// is one. It will then be stored under the current key. Results
+		// cached before mortality rates were stored can't be used to
+		// calculate deaths, so they are recomputed instead.
+		r := result.(*inmapResult)
+		if err := j.c.legacyCache.NewRequest(ctx, j.c.storedJob(legacyJob(j.legacyKey()))).Result(r); err == nil {
+			if len(r.MortalityRate) == len(r.Grid) && len(r.Grid) > 0 {
+				log.Printf("cityaq: migrated cached result %s to %s", j.legacyKey(), j.Key())
+				return j.c.store.writeMeta(ctx, newCacheMeta(j), r)
+			}
+			log.Printf("cityaq: not migrating cached result %s, which has no mortality rates", j.legacyKey())
+			*r = inmapResult{}
+		}
+	}
+
+	// Allow the run to be stopped by CancelJob, even if it was started
+	// by a request that is waiting for the result.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	run, _ := j.c.jobs.start(j, rpc.JobState_EmissionsGridding, cancel)
+	err := j.runModel(ctx, result.(*inmapResult), j.progress(run))
+	j.finish(ctx, run, err)
+	if err != nil {
+		return err
+	}
+	return j.c.store.writeMeta(ctx, newCacheMeta(j), result.(*inmapResult))
+}
+
+// runModel runs the air quality model for the receiver and stores the
+// output in result.
+func (j *concentrationJob) runModel(ctx context.Context, result *inmapResult, progress progressFunc) error {
+	progress(rpc.JobState_EmissionsGridding, "")
 	shpFile, err := j.emisToShp(ctx)
 	if err != nil {
 		return err
 	}
+	defer os.RemoveAll(filepath.Dir(shpFile))
 
 	cfg := inmaputil.InitializeConfig()
 	cfg.SetConfigFile(j.c.InMAPConfigFile)
@@ -173,13 +369,13 @@ func (j *concentrationJob) Run(ctx context.Context, result requestcache.Result)
 		return fmt.Errorf("cityaq: problem reading InMAP configuration file: %v", err)
 	}
 
-	ctx = context.WithValue(ctx, "user", "cityaq_user")
+	ctx = cloudContext(ctx)
 
 	cfg.Set("EmissionsShapefiles", []string{shpFile})
 	cfg.Set("job_name", j.Key())
 	cfg.Set("cmds", []string{"run", "steady"})
 
-  cityGeom, err := j.c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName:j.CityName})
+	cityGeom, err := j.c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName: j.CityName})
 	if err != nil {
 		return err
 	}
@@ -213,56 +409,7 @@ func (j *concentrationJob) Run(ctx context.Context, result requestcache.Result)
 	cfg.Set("VarGrid.Xnests", intSliceToArg(vgc.Xnests))
 	cfg.Set("VarGrid.Ynests", intSliceToArg(vgc.Ynests))
 
-	in, err := cloud.JobSpec(
-		cfg.Root, cfg.Viper,
-		cfg.GetString("job_name"),
-		cfg.GetStringSlice("cmds"),
-		cfg.InputFiles(),
-		int32(cfg.GetInt("memory_gb")),
-	)
-	if err != nil {
-		return err
-	}
-	_, err = j.c.inmapClient.RunJob(ctx, in)
-	if err != nil {
-		return err
-	}
-
-	for {
-		status, err := j.c.inmapClient.Status(ctx, &cloudrpc.JobName{
-			Version: inmap.Version,
-			Name:    j.Key(),
-		})
-		if err != nil {
-			return err
-		}
-		if status.Status == cloudrpc.Status_Failed || status.Status == cloudrpc.Status_Missing {
-			return fmt.Errorf("job %s error: %s, %s", j.Key(), status.Status, status.Message)
-		} else if status.Status == cloudrpc.Status_Complete {
-			break
-		}
-		time.Sleep(10 * time.Minute)
-	}
-
-	output, err := j.c.inmapClient.Output(ctx, &cloudrpc.JobName{
-		Version: inmap.Version,
-		Name:    j.Key(),
-	})
-	if err != nil {
-		return err
-	}
-
-	if err := inmapOutputToResult(output, result); err != nil {
-		return err
-	}
-	if _, err := j.c.inmapClient.Delete(ctx, &cloudrpc.JobName{
-		Version: inmap.Version,
-		Name:    j.Key(),
-	}); err != nil {
-		return err
-	}
-
-	return nil
+	return j.c.model.run(ctx, j.Key(), cfg, result, progress)
 }
 
 // intSliceToArg takes an integer slice and returns
@@ -282,8 +429,9 @@ func roundUnit(x, unit float64) float64 {
 }
 
 // emisToShp calculates the emissions associated with this job and
-// saves them to a temporary shapefile.
-func (j *concentrationJob) emisToShp(ctx context.Context) (string, error) {
+// saves them to a temporary shapefile. The caller is responsible for
+// removing the directory containing the shapefile.
+func (j *concentrationJob) emisToShp(ctx context.Context) (file string, err error) {
 	eReq := &rpc.GriddedEmissionsRequest{
 		CityName:   j.CityName,
 		SourceType: j.SourceType,
@@ -298,25 +446,36 @@ func (j *concentrationJob) emisToShp(ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	file := filepath.Join(dir, "emissions.shp")
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	file = filepath.Join(dir, "emissions.shp")
 	type emisRecord struct {
 		geom.Polygon
-		PM2_5, VOC, NH3, NOx, SOx float64
-		Height, Diam, Temp,Velocity float64
+		PM2_5, VOC, NH3, NOx, SOx    float64
+		Height, Diam, Temp, Velocity float64
 	}
 	e, err := shp.NewEncoder(file, emisRecord{})
 	if err != nil {
 		return "", err
 	}
+	scale := func(pol rpc.Emission) float64 {
+		if j.Scenario == nil {
+			return 1
+		}
+		return j.Scenario[pol]
+	}
 	for i, p := range emis.Polygons {
 		v := emis.Emissions[i]
 		er := &emisRecord{
 			Polygon: rpcToGeom(p),
-			PM2_5:   v,
-			VOC:     v,
-			NH3:     v,
-			NOx:     v,
-			SOx:     v,
+			PM2_5:   v * scale(rpc.Emission_PM2_5),
+			VOC:     v * scale(rpc.Emission_VOC),
+			NH3:     v * scale(rpc.Emission_NH3),
+			NOx:     v * scale(rpc.Emission_NOx),
+			SOx:     v * scale(rpc.Emission_SOx),
 		}
 		if egugridEmissions(j.SourceType) {
 			// Average EGU stack parameters from 2014 NEI
@@ -344,32 +503,75 @@ func (j *concentrationJob) emisToShp(ctx context.Context) (string, error) {
 }
 
 type inmapResult struct {
-	Grid       []geom.Polygon
-	Population []float64
-	//MortalityRate []float64
-	PrimaryPM25 []float64
-	SOA         []float64
-	PNH4        []float64
-	PNO3        []float64
-	PSO4        []float64
+	Grid          []geom.Polygon
+	Population    []float64
+	MortalityRate []float64
+	PrimaryPM25   []float64
+	SOA           []float64
+	PNH4          []float64
+	PNO3          []float64
+	PSO4          []float64
+
+	// BaselineTotalPM25 is the total PM2.5 concentration from
+	// all sources in the InMAP input data.
+	BaselineTotalPM25 []float64
+}
+
+// species returns the concentrations of the PM2.5 species formed
+// from emissions of the given pollutant.
+func (r *inmapResult) species(e rpc.Emission) ([]float64, bool) {
+	switch e {
+	case rpc.Emission_PM2_5:
+		return r.PrimaryPM25, true
+	case rpc.Emission_NH3:
+		return r.PNH4, true
+	case rpc.Emission_NOx:
+		return r.PNO3, true
+	case rpc.Emission_SOx:
+		return r.PSO4, true
+	case rpc.Emission_VOC:
+		return r.SOA, true
+	default:
+		return nil, false
+	}
+}
+
+// totalPM25 returns the sum of the concentrations of all PM2.5 species,
+// with the species formed from each precursor multiplied by the scale
+// of its emissions. If scales is nil, the species are not scaled.
+func (r *inmapResult) totalPM25(scales map[rpc.Emission]float64) []float64 {
+	o := make([]float64, len(r.PrimaryPM25))
+	for _, p := range precursors {
+		scale := 1.0
+		if scales != nil {
+			scale = scales[p]
+		}
+		species, _ := r.species(p)
+		for i, v := range species {
+			o[i] += v * scale
+		}
+	}
+	return o
 }
 
 type wrapInmapResult struct {
-	Grid       []geom.Polygon
-	Population []float64
-	//MortalityRate []float64
-	PrimaryPM25 []float64
-	SOA         []float64
-	PNH4        []float64
-	PNO3        []float64
-	PSO4        []float64
+	Grid              []geom.Polygon
+	Population        []float64
+	MortalityRate     []float64
+	PrimaryPM25       []float64
+	SOA               []float64
+	PNH4              []float64
+	PNO3              []float64
+	PSO4              []float64
+	BaselineTotalPM25 []float64
 }
 
 func (r *inmapResult) MarshalBinary() ([]byte, error) {
 	w := wrapInmapResult{Grid: r.Grid, Population: r.Population,
-		//MortalityRate: r.MortalityRate,
-		PrimaryPM25: r.PrimaryPM25,
-		SOA:         r.SOA, PNH4: r.PNH4, PNO3: r.PNO3, PSO4: r.PSO4}
+		MortalityRate: r.MortalityRate,
+		PrimaryPM25:   r.PrimaryPM25,
+		SOA:           r.SOA, PNH4: r.PNH4, PNO3: r.PNO3, PSO4: r.PSO4,
+		BaselineTotalPM25: r.BaselineTotalPM25}
 	var b bytes.Buffer
 	enc := gob.NewEncoder(&b)
 	if err := enc.Encode(w); err != nil {
@@ -386,12 +588,13 @@ func (r *inmapResult) UnmarshalBinary(b []byte) error {
 	}
 	r.Grid = w.Grid
 	r.Population = w.Population
-	//r.MortalityRate = w.MortalityRate
+	r.MortalityRate = w.MortalityRate
 	r.PrimaryPM25 = w.PrimaryPM25
 	r.SOA = w.SOA
 	r.PNH4 = w.PNH4
 	r.PNO3 = w.PNO3
 	r.PSO4 = w.PSO4
+	r.BaselineTotalPM25 = w.BaselineTotalPM25
 	return nil
 }
 
@@ -404,13 +607,15 @@ type inmapTempResult struct {
 	PNH4          float64 `shp:"pNH4"`
 	PNO3          float64 `shp:"pNO3"`
 	PSO4          float64 `shp:"pSO4"`
+	BaselinePM25  float64 `shp:"basePM25"`
 }
 
-func inmapOutputToResult(out *cloudrpc.JobOutput, result requestcache.Result) error {
+func inmapOutputToResult(out *cloudrpc.JobOutput, result *inmapResult) error {
 	dir, err := ioutil.TempDir("", "cityaq_output")
 	if err != nil {
 		return err
 	}
+	defer os.RemoveAll(dir)
 	for n, d := range out.Files {
 		file := filepath.Join(dir, n)
 		w, err := os.Create(file)
@@ -422,20 +627,25 @@ func inmapOutputToResult(out *cloudrpc.JobOutput, result requestcache.Result) er
 		}
 		w.Close()
 	}
-	d, err := shp.NewDecoder(filepath.Join(dir, "OutputFile.shp"))
+	return readInmapOutput(filepath.Join(dir, "OutputFile.shp"), result)
+}
+
+// readInmapOutput reads the InMAP output shapefile in file into o.
+func readInmapOutput(file string, o *inmapResult) error {
+	d, err := shp.NewDecoder(file)
 	if err != nil {
 		return err
 	}
 	defer d.Close()
-	o := result.(*inmapResult)
 	o.Grid = make([]geom.Polygon, d.AttributeCount())
 	o.Population = make([]float64, d.AttributeCount())
-	//o.MortalityRate = make([]float64, d.AttributeCount())
+	o.MortalityRate = make([]float64, d.AttributeCount())
 	o.PrimaryPM25 = make([]float64, d.AttributeCount())
 	o.SOA = make([]float64, d.AttributeCount())
 	o.PNH4 = make([]float64, d.AttributeCount())
 	o.PNO3 = make([]float64, d.AttributeCount())
 	o.PSO4 = make([]float64, d.AttributeCount())
+	o.BaselineTotalPM25 = make([]float64, d.AttributeCount())
 	var i int
 	for {
 		var rec inmapTempResult
@@ -444,12 +654,13 @@ func inmapOutputToResult(out *cloudrpc.JobOutput, result requestcache.Result) er
 		}
 		o.Grid[i] = rec.Polygon
 		o.Population[i] = rec.Population
-		//o.MortalityRate[i] = rec.MortalityRate
+		o.MortalityRate[i] = rec.MortalityRate
 		o.PrimaryPM25[i] = rec.PrimaryPM25
 		o.SOA[i] = rec.SOA
 		o.PNH4[i] = rec.PNH4
 		o.PNO3[i] = rec.PNO3
 		o.PSO4[i] = rec.PSO4
+		o.BaselineTotalPM25[i] = rec.BaselinePM25
 		i++
 	}
 	if err := d.Error(); err != nil {
@@ -3,12 +3,20 @@ package cityaq
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/geom"
+	"github.com/ctessum/geom/encoding/shp"
+	"github.com/ctessum/requestcache/v3"
 	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"github.com/spatialmodel/inmap/inmaputil"
 	"gonum.org/v1/gonum/floats"
 )
 
@@ -45,3 +53,434 @@ func TestCityAQ_GriddedConcentrations(t *testing.T) {
 		t.Errorf("concentration sum: %g != %g", concSum, wantConcSum)
 	}
 }
+
+func TestConcentrationJob_emisToShp(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+	}
+	scenario, err := scenarioScales(&rpc.EmissionScenario{
+		Emissions: []*rpc.PollutantEmission{
+			{Emission: rpc.Emission_PM2_5, Amount: 500, Unit: "t/yr"},
+			{Emission: rpc.Emission_NOx, Amount: 2, Unit: "kt/yr"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	j, err := c.newConcentrationJob("Accra Metropolitan", "electric_gen_egugrid", scenario)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := j.emisToShp(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filepath.Dir(file))
+
+	d, err := shp.NewDecoder(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	var pm25, nox, sox float64
+	for {
+		var rec struct {
+			geom.Polygon
+			PM2_5, NOx, SOx float64
+		}
+		if more := d.DecodeRow(&rec); !more {
+			break
+		}
+		pm25 += rec.PM2_5
+		nox += rec.NOx
+		sox += rec.SOx
+	}
+	if err := d.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if !similar(pm25, 5.0e5, 1.0e-8) {
+		t.Errorf("PM2.5: have %g, want %g", pm25, 5.0e5)
+	}
+	if !similar(nox, 2.0e6, 1.0e-8) {
+		t.Errorf("NOx: have %g, want %g", nox, 2.0e6)
+	}
+	if sox != 0 {
+		t.Errorf("SOx: have %g, want 0", sox)
+	}
+}
+
+func TestInmapResult_totalPM25(t *testing.T) {
+	r := &inmapResult{
+		PrimaryPM25: []float64{1, 2},
+		PNH4:        []float64{0.1, 0.2},
+		PNO3:        []float64{0.01, 0.02},
+		PSO4:        []float64{0.001, 0.002},
+		SOA:         []float64{0.0001, 0.0002},
+	}
+	want := []float64{1.1111, 2.2222}
+	have := r.totalPM25(nil)
+	for i := range want {
+		if !similar(have[i], want[i], 1.0e-10) {
+			t.Errorf("cell %d: have %g, want %g", i, have[i], want[i])
+		}
+	}
+
+	want = []float64{0.5 + 0.02, 1 + 0.04}
+	have = r.totalPM25(map[rpc.Emission]float64{rpc.Emission_PM2_5: 0.5, rpc.Emission_NOx: 2})
+	for i := range want {
+		if !similar(have[i], want[i], 1.0e-10) {
+			t.Errorf("scaled cell %d: have %g, want %g", i, have[i], want[i])
+		}
+	}
+}
+
+func TestConcentrationJob_Key(t *testing.T) {
+	newCityAQ := func() *CityAQ {
+		return &CityAQ{
+			CityGeomDir: "testdata/cities",
+			SpatialConfig: aeputil.SpatialConfig{
+				SrgSpecOSM:   "testdata/srgspec_osm.json",
+				SrgSpecSMOKE: "testdata/srgspec_smoke.csv",
+			},
+			InMAPConfigFile: "testdata/inmap_config.toml",
+		}
+	}
+	key := func(c *CityAQ, sourceType string, scenario map[rpc.Emission]float64) string {
+		j, err := c.newConcentrationJob("Accra Metropolitan", sourceType, scenario)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return j.Key()
+	}
+	base := key(newCityAQ(), "roadways", nil)
+
+	if !regexp.MustCompile("^[a-z0-9]+$").MatchString(base) || len(base) > 45 {
+		t.Errorf("invalid key %s", base)
+	}
+	if k := key(newCityAQ(), "roadways", nil); k != base {
+		t.Errorf("key should not change: %s != %s", k, base)
+	}
+	if k := key(newCityAQ(), "railways", nil); k == base {
+		t.Error("source type should change key")
+	}
+	if k := key(newCityAQ(), "roadways", map[rpc.Emission]float64{rpc.Emission_NOx: 1}); k == base {
+		t.Error("scenario should change key")
+	}
+	c := newCityAQ()
+	c.Backend = SRBackend
+	if k := key(c, "roadways", nil); k == base {
+		t.Error("SR matrix backend should change key")
+	}
+
+	b, err := ioutil.ReadFile("testdata/inmap_config.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "inmap_config*.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(append(b, []byte("\nNumIterations = 1\n")...)); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	c = newCityAQ()
+	c.InMAPConfigFile = f.Name()
+	if k := key(c, "roadways", nil); k == base {
+		t.Error("InMAP configuration should change key")
+	}
+
+	j, err := newCityAQ().newConcentrationJob("Accra Metropolitan", "roadways", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "concentrationaccraroadways"; j.legacyKey() != want {
+		t.Errorf("legacy key: have %s, want %s", j.legacyKey(), want)
+	}
+
+	if _, err := newCityAQ().newConcentrationJob("Atlantis", "roadways", nil); err == nil {
+		t.Error("expected an error for an invalid city")
+	}
+}
+
+// linearModel is a concentrationModel in which the concentration of
+// each PM2.5 species in each emissions grid cell is proportional to
+// the emissions of its precursor in the cell.
+type linearModel struct{}
+
+func (linearModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	d, err := shp.NewDecoder(cfg.GetStringSlice("EmissionsShapefiles")[0])
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	for {
+		var rec struct {
+			geom.Polygon
+			PM2_5, VOC, NH3, NOx, SOx float64
+		}
+		if more := d.DecodeRow(&rec); !more {
+			break
+		}
+		result.Grid = append(result.Grid, rec.Polygon)
+		result.Population = append(result.Population, 1000)
+		result.MortalityRate = append(result.MortalityRate, 800)
+		result.BaselineTotalPM25 = append(result.BaselineTotalPM25, 10)
+		result.PrimaryPM25 = append(result.PrimaryPM25, 1.0e-6*rec.PM2_5)
+		result.PNH4 = append(result.PNH4, 2.0e-6*rec.NH3)
+		result.PNO3 = append(result.PNO3, 3.0e-6*rec.NOx)
+		result.PSO4 = append(result.PSO4, 4.0e-6*rec.SOx)
+		result.SOA = append(result.SOA, 5.0e-6*rec.VOC)
+	}
+	return d.Error()
+}
+
+func TestCityAQ_totalPM25(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           linearModel{},
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+	const city, sourceType = "Accra Metropolitan", "electric_gen_egugrid"
+
+	// sum returns the sum of the concentrations resulting from
+	// each of the given emissions separately.
+	sum := func(emissions []*rpc.PollutantEmission) []float64 {
+		var o []float64
+		for _, e := range emissions {
+			conc, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
+				CityName:       city,
+				SourceType:     sourceType,
+				Emission:       e.Emission,
+				EmissionAmount: e.Amount,
+				EmissionUnit:   e.Unit,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o == nil {
+				o = make([]float64, len(conc.Concentrations))
+			}
+			floats.Add(o, conc.Concentrations)
+		}
+		return o
+	}
+	compare := func(have, want []float64) {
+		t.Helper()
+		if len(have) != len(want) || floats.Sum(want) == 0 {
+			t.Fatalf("invalid lengths or sum: %d, %d, %g", len(have), len(want), floats.Sum(want))
+		}
+		for i := range want {
+			if !similar(have[i], want[i], 1.0e-8) {
+				t.Errorf("cell %d: have %g, want %g", i, have[i], want[i])
+			}
+		}
+	}
+
+	t.Run("scenario", func(t *testing.T) {
+		scenario := &rpc.EmissionScenario{Emissions: []*rpc.PollutantEmission{
+			{Emission: rpc.Emission_PM2_5, Amount: 500, Unit: "t/yr"},
+			{Emission: rpc.Emission_NOx, Amount: 2, Unit: "kt/yr"},
+		}}
+		conc, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
+			CityName:   city,
+			SourceType: sourceType,
+			Scenario:   scenario,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		compare(conc.Concentrations, sum(scenario.Emissions))
+
+		s, err := c.ImpactSummary(ctx, &rpc.ImpactSummaryRequest{
+			CityName:   city,
+			SourceType: sourceType,
+			Scenario:   scenario,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pop, err := c.GriddedPopulation(ctx, &rpc.GriddedPopulationRequest{CityName: city, SourceType: sourceType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The intake fraction is for 2.5 kt/yr of total emissions.
+		if want := iF(conc.Concentrations, pop.Population, 2.5e6); !similar(s.TotalIF, want, 1.0e-8) {
+			t.Errorf("intake fraction: have %g, want %g", s.TotalIF, want)
+		}
+	})
+
+	t.Run("TotalPM25", func(t *testing.T) {
+		conc, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
+			CityName:       city,
+			SourceType:     sourceType,
+			EmissionAmount: 2,
+			EmissionUnit:   "kt/yr",
+			TotalPM25:      true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var emissions []*rpc.PollutantEmission
+		for _, p := range precursors {
+			emissions = append(emissions, &rpc.PollutantEmission{Emission: p, Amount: 2, Unit: "kt/yr"})
+		}
+		compare(conc.Concentrations, sum(emissions))
+	})
+}
+
+func TestCityAQ_GriddedConcentrations_compact(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           linearModel{},
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+	req := &rpc.GriddedConcentrationsRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Scenario: &rpc.EmissionScenario{Emissions: []*rpc.PollutantEmission{
+			{Emission: rpc.Emission_PM2_5, Amount: 1},
+			{Emission: rpc.Emission_SOx, Amount: 2},
+		}},
+	}
+	full, err := c.GriddedConcentrations(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Encoding = rpc.GridEncoding_CompactEncoding
+	compact, err := c.GriddedConcentrations(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(compact.Polygons)+len(compact.Concentrations)+len(compact.PrimaryPM25)+len(compact.PNH4)+
+		len(compact.PNO3)+len(compact.PSO4)+len(compact.SOA)+len(compact.BaselineTotalPM25) != 0 {
+		t.Error("compact response has double-precision values")
+	}
+	for _, v := range []struct {
+		name string
+		have []float32
+		want []float64
+	}{
+		{"Values", compact.Values, full.Concentrations},
+		{"PrimaryPM25", compact.PrimaryPM25Values, full.PrimaryPM25},
+		{"PNH4", compact.PNH4Values, full.PNH4},
+		{"PNO3", compact.PNO3Values, full.PNO3},
+		{"PSO4", compact.PSO4Values, full.PSO4},
+		{"SOA", compact.SOAValues, full.SOA},
+		{"BaselineTotalPM25", compact.BaselineTotalPM25Values, full.BaselineTotalPM25},
+	} {
+		if len(v.have) != len(v.want) || len(v.want) == 0 {
+			t.Errorf("%s: have %d values, want %d", v.name, len(v.have), len(v.want))
+			continue
+		}
+		for i := range v.want {
+			if !similar(float64(v.have[i]), v.want[i], 1.0e-6) {
+				t.Errorf("%s cell %d: have %g, want %g", v.name, i, v.have[i], v.want[i])
+			}
+		}
+	}
+}
+
+// fixedResultJob is a requestcache job whose result is a copy of r.
+type fixedResultJob struct {
+	key string
+	r   inmapResult
+}
+
+func (j fixedResultJob) Key() string { return j.key }
+
+func (j fixedResultJob) Run(_ context.Context, result requestcache.Result) error {
+	*result.(*inmapResult) = j.r
+	return nil
+}
+
+func TestConcentrationJob_Run_legacyWithoutMortality(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	release := make(chan struct{})
+	close(release)
+	m := gatedModel{runs: new(int32), release: release}
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile:        "testdata/inmap_config.toml",
+		CacheLoc:               dir,
+		MigrateLegacyCacheKeys: true,
+		model:                  m,
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	if err := c.setupCache(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	j, err := c.newConcentrationJob("Accra Metropolitan", "electric_gen_egugrid", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Store a result in the format used before mortality rates were saved.
+	legacy := fixedResultJob{key: j.legacyKey(), r: inmapResult{
+		Grid:        []geom.Polygon{{{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}}},
+		Population:  []float64{1000},
+		PrimaryPM25: []float64{1},
+	}}
+	if err := c.legacyCache.NewRequest(ctx, c.storedJob(legacy)).Result(new(inmapResult)); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := c.concentrationResult(ctx, j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runs := atomic.LoadInt32(m.runs); runs != 1 {
+		t.Errorf("model ran %d times; the legacy result should be recomputed", runs)
+	}
+	if len(result.MortalityRate) != len(result.Grid) || len(result.Grid) < 2 {
+		t.Errorf("result has %d mortality rates for %d grid cells", len(result.MortalityRate), len(result.Grid))
+	}
+}
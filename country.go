@@ -1,8 +1,11 @@
 package cityaq
 
 import (
+	"encoding/csv"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/ctessum/geom"
@@ -13,7 +16,9 @@ import (
 // country returns the name and geometry of the country that the
 // given city is nearest to.
 func (c *CityAQ) country(cityName string) (*country, error) {
-	c.loadCountries()
+	if err := c.loadCountries(); err != nil {
+		return nil, err
+	}
 	cityGeom, err := c.geojsonGeometry(cityName)
 	if err != nil {
 		return nil, err
@@ -31,7 +36,7 @@ func (c *CityAQ) country(cityName string) (*country, error) {
 		}
 	}
 	if ctry == nil {
-		return nil, fmt.Errorf("couldn't match country to city %s", cityName)
+		return nil, failedPrecondition("COUNTRY", cityName, "cityaq: couldn't match country to city %s", cityName)
 	}
 	return ctry, nil
 }
@@ -41,20 +46,68 @@ type country struct {
 	Name string `shp:"CNTRY_NAME"`
 }
 
-func (c *CityAQ) loadCountries() {
-	c.loadCountriesOnce.Do(func() {
-		c.countries = rtree.NewTree(25, 50)
+// loadCountries loads the country boundaries, if they haven't been
+// loaded already.
+func (c *CityAQ) loadCountries() error {
+	return c.loadCountriesOnce.Do(func() error {
+		countries := rtree.NewTree(25, 50)
 		d, err := shp.NewDecoder(filepath.Join(c.SpatialConfig.SrgShapefileDirectory, "Countries_WGS84.shp"))
 		if err != nil {
-			panic(err)
+			return dependencyUnavailable(dependencyCountries, err)
 		}
+		defer d.Close()
 		for {
 			var row country
 			if more := d.DecodeRow(&row); !more {
 				break
 			}
-			c.countries.Insert(&row)
+			countries.Insert(&row)
+		}
+		if err := d.Error(); err != nil {
+			return dependencyUnavailable(dependencyCountries, err)
+		}
+		c.countries = countries
+		return nil
+	})
+}
+
+// defaultReferenceCountry is the default value of the ReferenceCountry
+// field of CityAQ.
+const defaultReferenceCountry = "United States"
+
+// loadCountryIncomes loads the per-capita incomes in CountryIncomeFile,
+// if they haven't been loaded already.
+func (c *CityAQ) loadCountryIncomes() error {
+	return c.loadCountryIncomesOnce.Do(func() error {
+		if c.CountryIncomeFile == "" {
+			c.countryIncomes = make(map[string]float64)
+			return nil
+		}
+		f, err := os.Open(os.ExpandEnv(c.CountryIncomeFile))
+		if err != nil {
+			return dependencyUnavailable(dependencyIncomes, err)
+		}
+		defer f.Close()
+		recs, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			return dependencyUnavailable(dependencyIncomes, err)
+		}
+		incomes := make(map[string]float64)
+		for i, rec := range recs {
+			if i == 0 {
+				continue // Skip the header.
+			}
+			if len(rec) != 2 {
+				return dependencyUnavailable(dependencyIncomes, fmt.Errorf("line %d has %d columns instead of 2", i+1, len(rec)))
+			}
+			income, err := strconv.ParseFloat(strings.TrimSpace(rec[1]), 64)
+			if err != nil {
+				return dependencyUnavailable(dependencyIncomes, fmt.Errorf("line %d: %w", i+1, err))
+			}
+			incomes[strings.TrimSpace(rec[0])] = income
 		}
+		c.countryIncomes = incomes
+		return nil
 	})
 }
 
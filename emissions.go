@@ -3,6 +3,7 @@ package cityaq
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
@@ -14,6 +15,8 @@ import (
 	"github.com/paulmach/orb/geojson"
 	"github.com/spatialmodel/inmap/emissions/aep"
 	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type emissions struct {
@@ -29,16 +32,8 @@ func (e *emissions) Location() *aep.Location {
 	return &aep.Location{Geom: e.Polygon, SR: e.SR, Name: e.cityName}
 }
 
-func newEmissions(poly geom.Polygon, pollutant rpc.Emission, sourceType, cityName string) (*emissions, time.Time, time.Time, error) {
-	begin := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
-
-	const kt = 1.0e6 // kilograms
-	duration := end.Sub(begin).Seconds()
-	rate := unit.New(kt/duration, unit.Dimensions{
-		unit.MassDim: 1,
-		unit.TimeDim: -1,
-	}) // 1 kilotonne/year in kg/s
+func newEmissions(poly geom.Polygon, pollutant rpc.Emission, sourceType, cityName string, rate *unit.Unit) (*emissions, time.Time, time.Time, error) {
+	begin, end := emissionsPeriod()
 
 	e := new(aep.Emissions)
 	e.Add(begin, end, pollutant.String(), "", rate)
@@ -62,15 +57,130 @@ func newEmissions(poly geom.Polygon, pollutant rpc.Emission, sourceType, cityNam
 	return emis, begin, end, nil
 }
 
+// emissionsPeriod returns the beginning and end of the time period
+// over which emissions are spread.
+func emissionsPeriod() (begin, end time.Time) {
+	begin = time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end = time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return
+}
+
+// emissionsUnits holds the units that can be used to specify
+// emissions amounts, which can also be combined with the prefixes
+// in siPrefixes. A year is the length of the emissions period.
+var emissionsUnits = func() map[string]*unit.Unit {
+	mass := func(kg float64) *unit.Unit { return unit.New(kg, unit.Dimensions{unit.MassDim: 1}) }
+	duration := func(s float64) *unit.Unit { return unit.New(s, unit.Dimensions{unit.TimeDim: 1}) }
+	begin, end := emissionsPeriod()
+	year := duration(end.Sub(begin).Seconds())
+	return map[string]*unit.Unit{
+		"g":     mass(1.0e-3),
+		"t":     mass(1.0e3),
+		"tonne": mass(1.0e3),
+		"s":     duration(1),
+		"min":   duration(60),
+		"h":     duration(60 * 60),
+		"hr":    duration(60 * 60),
+		"d":     duration(24 * 60 * 60),
+		"day":   duration(24 * 60 * 60),
+		"yr":    year,
+		"year":  year,
+		"a":     year,
+	}
+}()
+
+// siPrefixes holds the SI prefixes that can be used with emissionsUnits.
+var siPrefixes = map[string]float64{
+	"μ": 1.0e-6,
+	"u": 1.0e-6,
+	"m": 1.0e-3,
+	"k": 1.0e3,
+	"M": 1.0e6,
+	"G": 1.0e9,
+}
+
+// parseUnit returns one of the given symbol, which is a unit in
+// emissionsUnits, optionally preceded by a prefix in siPrefixes
+// or followed by a plural "s".
+func parseUnit(symbol string) (*unit.Unit, bool) {
+	symbol = strings.TrimSpace(symbol)
+	if u, ok := emissionsUnits[symbol]; ok {
+		return u.Clone(), true
+	}
+	if u, ok := emissionsUnits[strings.TrimSuffix(symbol, "s")]; ok && len(symbol) > 2 {
+		return u.Clone(), true
+	}
+	for prefix, factor := range siPrefixes {
+		if u, ok := emissionsUnits[strings.TrimPrefix(symbol, prefix)]; ok && strings.HasPrefix(symbol, prefix) {
+			return unit.Mul(u, unit.New(factor, unit.Dimensions{})), true
+		}
+	}
+	return nil, false
+}
+
+// emissionsRate returns the emissions rate (in kg/s) specified by amount
+// and rateUnit. rateUnit must be in the form "mass/time", for example
+// "t/yr", "kg/day" or "kt/yr", where a year is the length of the
+// emissions period. If rateUnit is empty "kt/yr" is assumed, and if
+// amount is zero, as it is when it is unset, an amount of 1 is assumed.
+func emissionsRate(amount float64, rateUnit string) (*unit.Unit, error) {
+	if amount < 0 {
+		return nil, invalidArgument("EmissionAmount", "cityaq: emissions amount must be >= 0 but is %g", amount)
+	}
+	if amount == 0 {
+		amount = 1
+	}
+	if rateUnit == "" {
+		rateUnit = "kt/yr"
+	}
+	parts := strings.Split(rateUnit, "/")
+	if len(parts) != 2 {
+		return nil, invalidArgument("EmissionUnit", "cityaq: invalid emissions unit %q; it should be in the form mass/time", rateUnit)
+	}
+	mass, ok := parseUnit(parts[0])
+	if !ok {
+		return nil, invalidArgument("EmissionUnit", "cityaq: invalid emissions mass unit %q", parts[0])
+	}
+	duration, ok := parseUnit(parts[1])
+	if !ok {
+		return nil, invalidArgument("EmissionUnit", "cityaq: invalid emissions time unit %q", parts[1])
+	}
+	rate := unit.Div(unit.Mul(unit.New(amount, unit.Dimensions{}), mass), duration)
+	if err := rate.Check(unit.Dimensions{unit.MassDim: 1, unit.TimeDim: -1}); err != nil {
+		return nil, invalidArgument("EmissionUnit", "cityaq: invalid emissions unit %q; it should be in the form mass/time: %v", rateUnit, err)
+	}
+	return rate, nil
+}
+
+// emissionsScale returns the ratio of the emissions rate specified by
+// amount and rateUnit to the default rate of 1 kilotonne per year.
+func emissionsScale(amount float64, rateUnit string) (float64, error) {
+	rate, err := emissionsRate(amount, rateUnit)
+	if err != nil {
+		return 0, err
+	}
+	base, err := emissionsRate(1, "kt/yr")
+	if err != nil {
+		return 0, err
+	}
+	return rate.Value() / base.Value(), nil
+}
+
 func emissionsMapName(r *rpc.GriddedEmissionsRequest) string {
 	return fmt.Sprintf("%s_%d_%d_%s", r.CityName, rpc.ImpactType_Emissions, r.Emission, r.SourceType)
 }
 
 func concentrationsMapName(r *rpc.GriddedConcentrationsRequest) string {
-	return fmt.Sprintf("%s_%d_%d_%s", r.CityName, rpc.ImpactType_Concentrations, r.Emission, r.SourceType)
+	it := rpc.ImpactType_Concentrations
+	if r.TotalPM25 {
+		it = rpc.ImpactType_TotalConcentrations
+	}
+	return fmt.Sprintf("%s_%d_%d_%s", r.CityName, it, r.Emission, r.SourceType)
 }
 
-// GriddedEmissions returns gridded emissions for the request.
+// GriddedEmissions returns gridded emissions for the request, in kg per
+// year. The total emissions rate is specified by req.EmissionAmount and
+// req.EmissionUnit, with a default of 1 kilotonne per year.
 // If req.SourceType has the suffix "_egugrid", emissions will be allocated
 // to the smaller of country that the city is in or the intersection of
 // the country with a 5.4 degree radius buffer around the city,
@@ -80,6 +190,12 @@ func (c *CityAQ) GriddedEmissions(ctx context.Context, req *rpc.GriddedEmissions
 	if err != nil {
 		return nil, err
 	}
+	// The request may refer to the city by its ID, so use the file's
+	// name for the city wherever the result depends on it.
+	cf, err := c.cityFile(req.CityName)
+	if err != nil {
+		return nil, err
+	}
 	if egugridEmissions(req.SourceType) {
 		// Use EGU grid geometry instead of city.
 		country, err := c.countryOrGridBuffer(req.CityName)
@@ -88,16 +204,24 @@ func (c *CityAQ) GriddedEmissions(ctx context.Context, req *rpc.GriddedEmissions
 		}
 		g = country.Polygon
 	}
-	e, begin, end, err := newEmissions(g, req.Emission, req.SourceType, req.CityName)
+	rate, err := emissionsRate(req.EmissionAmount, req.EmissionUnit)
+	if err != nil {
+		return nil, err
+	}
+	e, begin, end, err := newEmissions(g, req.Emission, req.SourceType, cf.name, rate)
 	if err != nil {
 		return nil, err
 	}
 
-	grid, err := c.emissionsGrid(req.CityName, req.SourceType, mapResolution(req.SourceType, req.CityName))
+	grid, err := c.emissionsGrid(cf.name, req.SourceType, mapResolution(req.SourceType, cf.name))
 	if err != nil {
 		return nil, err
 	}
 
+	// Surrogates are cached by grid name, so include the version
+	// of the city boundary, which the grid depends on.
+	gridName := cf.name + "_" + cf.version
+
 	// Make a copy of the spatial configuration to allow the
 	// use of multiple grids.
 	spatialConfig := aeputil.SpatialConfig{
@@ -112,7 +236,7 @@ func (c *CityAQ) GriddedEmissions(ctx context.Context, req *rpc.GriddedEmissions
 		SpatialCache:          c.SpatialConfig.SpatialCache,
 		MaxCacheEntries:       c.SpatialConfig.MaxCacheEntries,
 		GridCells:             grid,
-		GridName:              req.CityName,
+		GridName:              gridName,
 	}
 
 	sp, err := spatialConfig.SpatialProcessor()
@@ -128,11 +252,11 @@ func (c *CityAQ) GriddedEmissions(ctx context.Context, req *rpc.GriddedEmissions
 		return nil, err
 	}
 	if len(gridEmis) == 0 {
-		return nil, fmt.Errorf("cityaq: no emissions for city %s, source %s", req.CityName, req.SourceType)
+		return nil, failedPrecondition("NO_EMISSIONS", req.CityName+"/"+req.SourceType, "cityaq: no emissions for city %s, source %s", req.CityName, req.SourceType)
 	}
 	polEmis, ok := gridEmis[aep.Pollutant{Name: req.Emission.String()}]
 	if !ok {
-		panic(fmt.Errorf("cityaq: missing gridded pollutant %v", req.Emission))
+		return nil, status.Errorf(codes.Internal, "cityaq: missing gridded pollutant %v", req.Emission)
 	}
 
 	o := &rpc.GriddedEmissionsResponse{
@@ -142,6 +266,10 @@ func (c *CityAQ) GriddedEmissions(ctx context.Context, req *rpc.GriddedEmissions
 	for i, v := range polEmis.Elements {
 		o.Emissions[i] = v
 	}
+	if req.Encoding == rpc.GridEncoding_CompactEncoding {
+		o.Grid, o.Values = compactGrid(o.Polygons), float32s(o.Emissions)
+		o.Polygons, o.Emissions = nil, nil
+	}
 
 	return o, nil
 }
@@ -216,7 +344,7 @@ func rpcToGeom(p *rpc.Polygon) geom.Polygon {
 	for i, path := range p.Paths {
 		o[i] = make(geom.Path, len(path.Points))
 		for j, point := range path.Points {
-			o[i][j] = geom.Point{point.X, point.Y}
+			o[i][j] = geom.Point{X: point.X, Y: point.Y}
 		}
 	}
 	return o
@@ -0,0 +1,52 @@
+package cityaq
+
+import (
+	"testing"
+)
+
+func TestEmissionsRate(t *testing.T) {
+	const year = 366 * 24 * 60 * 60 // 2016 is a leap year.
+	tests := []struct {
+		amount float64
+		unit   string
+		want   float64 // kg/s
+	}{
+		{amount: 0, unit: "", want: 1.0e6 / year},
+		{amount: 1, unit: "", want: 1.0e6 / year},
+		{amount: 1, unit: "kt/yr", want: 1.0e6 / year},
+		{amount: 500, unit: "t/yr", want: 5.0e5 / year},
+		{amount: 10, unit: "kg/day", want: 10.0 / 24 / 60 / 60},
+		{amount: 2, unit: "g/s", want: 2.0e-3},
+		{amount: 0, unit: "t/yr", want: 1.0e3 / year},
+		{amount: 3, unit: "tonnes/day", want: 3.0e3 / 24 / 60 / 60},
+		{amount: 1, unit: "Mt/yr", want: 1.0e9 / year},
+		{amount: 60, unit: "mg/min", want: 1.0e-6},
+	}
+	for _, test := range tests {
+		t.Run(test.unit, func(t *testing.T) {
+			rate, err := emissionsRate(test.amount, test.unit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !similar(rate.Value(), test.want, 1.0e-10) {
+				t.Errorf("have %g, want %g", rate.Value(), test.want)
+			}
+		})
+	}
+
+	for _, u := range []string{"kt", "lb/yr", "kg/fortnight", "kg/kg", "s/kg"} {
+		t.Run("invalid_"+u, func(t *testing.T) {
+			if _, err := emissionsRate(1, u); err == nil {
+				t.Errorf("expected an error for unit %s", u)
+			}
+		})
+	}
+
+	scale, err := emissionsScale(500, "t/yr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !similar(scale, 0.5, 1.0e-10) {
+		t.Errorf("scale: have %g, want 0.5", scale)
+	}
+}
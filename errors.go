@@ -0,0 +1,138 @@
+package cityaq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// errorDomain is the domain of the errdetails.ErrorInfo attached to
+// errors returned by CityAQ.
+const errorDomain = "cityaq"
+
+// withDetails returns an error with the given code and message, with
+// details attached.
+func withDetails(code codes.Code, msg string, details ...protoiface.MessageV1) error {
+	st := status.New(code, msg)
+	if std, err := st.WithDetails(details...); err == nil {
+		st = std
+	}
+	return st.Err()
+}
+
+// invalidArgument returns an error indicating that the request field
+// with the given name has an invalid value.
+func invalidArgument(field, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	return withDetails(codes.InvalidArgument, msg, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: msg},
+		},
+	})
+}
+
+// notFound returns an error indicating that the resource with the given
+// type and name, which was specified in the named request field,
+// does not exist.
+func notFound(field, resourceType, name, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	return withDetails(codes.NotFound, msg,
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: name, Description: msg},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: msg},
+			},
+		},
+	)
+}
+
+// failedPrecondition returns an error indicating that the request is
+// valid but can't be fulfilled in the current state of the system.
+// violationType is a short upper-case description of the kind of
+// problem, and subject is the thing the problem is about.
+func failedPrecondition(violationType, subject, format string, a ...interface{}) error {
+	msg := fmt.Sprintf(format, a...)
+	return withDetails(codes.FailedPrecondition, msg, &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: msg},
+		},
+	})
+}
+
+// modelFailure returns an error indicating that the air quality model
+// failed to run, which is usually a temporary problem: the model will
+// be run again if the request is repeated.
+// Errors that already have a gRPC status are returned unchanged.
+func modelFailure(err error) error {
+	if _, ok := grpcStatus(err); ok {
+		return err
+	}
+	return withDetails(codes.Unavailable, err.Error(), &errdetails.ErrorInfo{
+		Reason: "MODEL_FAILED",
+		Domain: errorDomain,
+	})
+}
+
+// dependencyUnavailable returns an error indicating that the named
+// dependency could not be loaded. Loading will be attempted again
+// when the request is repeated.
+func dependencyUnavailable(dependency string, err error) error {
+	if _, ok := grpcStatus(err); ok {
+		return err
+	}
+	return withDetails(codes.Unavailable, fmt.Sprintf("cityaq: loading %s: %v", dependency, err), &errdetails.ErrorInfo{
+		Reason:   "DEPENDENCY_UNAVAILABLE",
+		Domain:   errorDomain,
+		Metadata: map[string]string{"dependency": dependency},
+	})
+}
+
+// grpcStatus returns the status of the first error in err's chain
+// that has one.
+func grpcStatus(err error) (*status.Status, bool) {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus(), true
+	}
+	return nil, false
+}
+
+// toStatusError converts err to an error with a gRPC status code. Errors
+// that don't already have a status are given codes.Canceled or
+// codes.DeadlineExceeded if they were caused by the request context,
+// or codes.Internal otherwise.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := grpcStatus(err); ok {
+		return st.Err()
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// unaryErrorInterceptor makes sure that all errors returned by unary
+// RPCs have a gRPC status code.
+func unaryErrorInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+// streamErrorInterceptor makes sure that all errors returned by
+// streaming RPCs have a gRPC status code.
+func streamErrorInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatusError(handler(srv, ss))
+}
@@ -0,0 +1,65 @@
+package cityaq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCodes(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	ctx := context.Background()
+
+	_, err := c.CityGeometry(ctx, &rpc.CityGeometryRequest{CityName: "Atlantis"})
+	st := status.Convert(err)
+	if st.Code() != codes.NotFound {
+		t.Fatalf("city: have code %s, want NotFound", st.Code())
+	}
+	var foundResource, foundField bool
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ResourceInfo:
+			foundResource = d.ResourceType == "city" && d.ResourceName == "Atlantis"
+		case *errdetails.BadRequest:
+			foundField = len(d.FieldViolations) == 1 && d.FieldViolations[0].Field == "CityName"
+		}
+	}
+	if !foundResource || !foundField {
+		t.Errorf("city: wrong details %v", st.Details())
+	}
+
+	_, err = emissionsRate(1, "kg")
+	st = status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("unit: have code %s, want InvalidArgument", st.Code())
+	}
+	if d, ok := st.Details()[0].(*errdetails.BadRequest); !ok || d.FieldViolations[0].Field != "EmissionUnit" {
+		t.Errorf("unit: wrong details %v", st.Details())
+	}
+}
+
+func TestToStatusError(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: fmt.Errorf("cityaq: job x: %w", context.Canceled), code: codes.Canceled},
+		{err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{err: errors.New("oops"), code: codes.Internal},
+		{err: modelFailure(errors.New("job failed")), code: codes.Unavailable},
+		{err: fmt.Errorf("wrapped: %w", invalidArgument("VSL", "bad")), code: codes.InvalidArgument},
+	} {
+		if code := status.Code(toStatusError(test.err)); code != test.code {
+			t.Errorf("%v: have code %s, want %s", test.err, code, test.code)
+		}
+	}
+	if toStatusError(nil) != nil {
+		t.Error("nil error should stay nil")
+	}
+}
@@ -3,8 +3,10 @@ module github.com/ctessum/cityaq
 go 1.13
 
 require (
+	cloud.google.com/go v0.44.3
 	github.com/andelf/go-curl v0.0.0-20200630032108-fd49ff24ed97 // indirect
 	github.com/andybalholm/brotli v0.0.0-20190821151343-b60f0d972eeb
+	github.com/aws/aws-sdk-go v1.17.6
 	github.com/cenkalti/backoff v2.0.0+incompatible
 	github.com/ctessum/geom v0.2.10-0.20200417141930-c1ad83ff7e0d
 	github.com/ctessum/go-leaflet v0.0.0-20190930105439-32c1547876af
@@ -13,7 +15,9 @@ require (
 	github.com/ctessum/sparse v0.0.0-20181201011727-57d6234a2c9d
 	github.com/ctessum/unit v0.0.0-20160621200450-755774ac2fcb
 	github.com/elazarl/go-bindata-assetfs v1.0.0
+	github.com/fsnotify/fsnotify v1.4.7
 	github.com/golang/gddo v0.0.0-20190904175337-72a348e765d2 // indirect
+	github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6
 	github.com/golang/mock v1.4.4
 	github.com/golang/protobuf v1.4.2
 	github.com/gonum/floats v0.0.0-20181209220543-c233463c7e82
@@ -31,6 +35,8 @@ require (
 	golang.org/x/sys v0.0.0-20190712062909-fae7ac547cb7 // indirect
 	gonum.org/v1/gonum v0.0.0-20191009222026-5d5638e6749a
 	gonum.org/v1/plot v0.0.0-20190615073203-9aa86143727f
+	google.golang.org/api v0.8.0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.28.0
 	google.golang.org/protobuf v1.25.0
 	k8s.io/client-go v10.0.0+incompatible
@@ -0,0 +1,82 @@
+package cityaq
+
+import (
+	"math"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/geom"
+)
+
+// gridTolerance is the tolerance, relative to the cell size, used
+// when checking whether a grid is regular.
+const gridTolerance = 1.0e-6
+
+// compactGrid returns a compact representation of the given
+// grid cells, which are assumed to be rectangles. If the cells
+// form a regular lattice, only its origin, cell size and
+// dimensions are included.
+func compactGrid(polys []*rpc.Polygon) *rpc.CompactGrid {
+	o := new(rpc.CompactGrid)
+	if len(polys) == 0 {
+		return o
+	}
+	bounds := make([]*geom.Bounds, len(polys))
+	for i, p := range polys {
+		bounds[i] = rpcToGeom(p).Bounds()
+	}
+	if nx, ny, ok := regularGrid(bounds); ok {
+		b := bounds[0]
+		o.X0, o.Y0 = b.Min.X, b.Min.Y
+		o.Dx, o.Dy = b.Max.X-b.Min.X, b.Max.Y-b.Min.Y
+		o.Nx, o.Ny = int32(nx), int32(ny)
+		return o
+	}
+	o.CellBounds = make([]float64, 0, 4*len(bounds))
+	for _, b := range bounds {
+		o.CellBounds = append(o.CellBounds, b.Min.X, b.Min.Y, b.Max.X, b.Max.Y)
+	}
+	return o
+}
+
+// regularGrid returns the numbers of columns and rows in the grid with
+// the given cell bounds, and whether the cells are the same size
+// and ordered by row, starting with the lower-left cell.
+func regularGrid(bounds []*geom.Bounds) (nx, ny int, ok bool) {
+	b0 := bounds[0]
+	dx, dy := b0.Max.X-b0.Min.X, b0.Max.Y-b0.Min.Y
+	if dx <= 0 || dy <= 0 {
+		return 0, 0, false
+	}
+	near := func(a, b, d float64) bool { return math.Abs(a-b) <= gridTolerance*d }
+	for _, b := range bounds {
+		if !near(b.Min.Y, b0.Min.Y, dy) {
+			break
+		}
+		nx++
+	}
+	if len(bounds)%nx != 0 {
+		return 0, 0, false
+	}
+	for i, b := range bounds {
+		x := b0.Min.X + float64(i%nx)*dx
+		y := b0.Min.Y + float64(i/nx)*dy
+		if !near(b.Min.X, x, dx) || !near(b.Max.X, x+dx, dx) ||
+			!near(b.Min.Y, y, dy) || !near(b.Max.Y, y+dy, dy) {
+			return 0, 0, false
+		}
+	}
+	return nx, len(bounds) / nx, true
+}
+
+// float32s returns v converted to single precision, or nil if v
+// is empty.
+func float32s(v []float64) []float32 {
+	if len(v) == 0 {
+		return nil
+	}
+	o := make([]float32, len(v))
+	for i, x := range v {
+		o[i] = float32(x)
+	}
+	return o
+}
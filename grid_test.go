@@ -0,0 +1,39 @@
+package cityaq
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ctessum/geom"
+)
+
+func TestCompactGrid(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	grid, err := c.emissionsGrid("Accra Metropolitan", "roadways", 0.002)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := compactGrid(polygonalsToRPC(grid))
+	if int(g.Nx*g.Ny) != len(grid) || len(g.CellBounds) != 0 {
+		t.Fatalf("grid should be regular: nx=%d, ny=%d, cells=%d", g.Nx, g.Ny, len(grid))
+	}
+	if !similar(g.Dx, 0.002, 1.0e-10) || !similar(g.Dy, 0.002, 1.0e-10) {
+		t.Errorf("wrong cell size: %g, %g", g.Dx, g.Dy)
+	}
+	b := grid[len(grid)-1].Bounds()
+	if !similar(g.X0+float64(g.Nx)*g.Dx, b.Max.X, 1.0e-10) || !similar(g.Y0+float64(g.Ny)*g.Dy, b.Max.Y, 1.0e-10) {
+		t.Errorf("wrong upper-right corner: %+v", b.Max)
+	}
+
+	irregular := []geom.Polygonal{
+		geom.Polygon{{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}},
+		geom.Polygon{{{X: 2, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 1}, {X: 2, Y: 1}}},
+	}
+	g = compactGrid(polygonalsToRPC(irregular))
+	if g.Nx != 0 {
+		t.Errorf("grid should not be regular")
+	}
+	if want := []float64{0, 0, 2, 2, 2, 0, 3, 1}; !reflect.DeepEqual(g.CellBounds, want) {
+		t.Errorf("cell bounds: %v != %v", g.CellBounds, want)
+	}
+}
@@ -1,6 +1,8 @@
 package cityaq
 
 import (
+	"context"
+	"log"
 	"net/http"
 	"strings"
 
@@ -19,13 +21,20 @@ type GRPCServer struct {
 	grpcServer   *grpcweb.WrappedGrpcServer
 	staticServer http.Handler
 	mapServer    *MapTileServer
+	adminServer  http.Handler
+	apiServer    http.Handler
+
+	readinessServer http.Handler
 
 	Log logrus.FieldLogger
 }
 
 // NewGRPCServer creates a new GRPC server for c.
 func NewGRPCServer(c *CityAQ) *GRPCServer {
-	gs := grpc.NewServer()
+	gs := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryErrorInterceptor),
+		grpc.StreamInterceptor(streamErrorInterceptor),
+	)
 	cityaqrpc.RegisterCityAQServer(gs, c)
 	s := new(GRPCServer)
 	s.grpcServer = grpcweb.WrapServer(gs)
@@ -38,6 +47,14 @@ func NewGRPCServer(c *CityAQ) *GRPCServer {
 		},
 	)))
 	s.mapServer = NewMapTileServer(c, 50)
+	s.adminServer = cacheAdminHandler{c: c}
+	s.apiServer = restHandler{c: c}
+	s.readinessServer = readinessHandler{c: c}
+	go func() {
+		if err := c.ResumeJobs(context.Background()); err != nil {
+			log.Println(err)
+		}
+	}()
 	return s
 }
 
@@ -79,6 +96,27 @@ func (s *GRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}).Info("cityaq map tile request")
 		}
 		s.mapServer.ServeHTTP(w, r)
+	} else if strings.HasPrefix(r.URL.Path, "/admin/cache") {
+		if s.Log != nil {
+			s.Log.WithFields(logrus.Fields{
+				"url":    r.URL.String(),
+				"method": r.Method,
+				"addr":   r.RemoteAddr,
+			}).Info("cityaq cache administration request")
+		}
+		s.adminServer.ServeHTTP(w, r)
+	} else if strings.HasPrefix(r.URL.Path, restPrefix) {
+		if s.Log != nil {
+			s.Log.WithFields(logrus.Fields{
+				"url":    r.URL.String(),
+				"method": r.Method,
+				"addr":   r.RemoteAddr,
+			}).Info("cityaq api request")
+		}
+		s.apiServer.ServeHTTP(w, r)
+	} else if r.URL.Path == readinessPath {
+		// Readiness checks are frequent, so they aren't logged.
+		s.readinessServer.ServeHTTP(w, r)
 	} else {
 		if s.Log != nil {
 			s.Log.WithFields(logrus.Fields{
@@ -88,6 +88,20 @@ func (c *CityAQ) Monitor() {
 	for _, s := range []js.Value{c.citySelector, c.impactTypeSelector, c.emissionSelector, c.sourceTypeSelector} {
 		s.Call("addEventListener", "change", cb)
 	}
+	c.doc.Call("getElementById", "downloadButton").Call("addEventListener", "click", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		go func() {
+			c.doc.Call("getElementById", "error").Set("innerHTML", "")
+			sel, err := c.selectorValues()
+			if err != nil {
+				c.logError(err)
+				return
+			}
+			if err := c.downloadData(context.TODO(), sel); err != nil {
+				c.logError(err)
+			}
+		}()
+		return nil
+	}))
 	for _, s := range []js.Value{c.citySelector, c.sourceTypeSelector} {
 		s.Call("addEventListener", "change", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 			go func() {
@@ -108,6 +122,7 @@ func (c *CityAQ) Monitor() {
 
 func (c *CityAQ) startLoading() {
 	go func() {
+		c.doc.Call("getElementById", "loading_text").Set("innerText", "Loading...")
 		for _, id := range []string{"loading", "loading_text", "loading_icon"} {
 			c.doc.Call("getElementById", id).Set("hidden", false)
 		}
@@ -127,6 +142,45 @@ func (c *CityAQ) stopLoading() {
 	}()
 }
 
+// jobStateText holds descriptions of the states of concentration jobs.
+var jobStateText = map[rpc.JobState]string{
+	rpc.JobState_UNKNOWN_JOBSTATE:  "Loading...",
+	rpc.JobState_Queued:            "Waiting for other simulations to finish...",
+	rpc.JobState_EmissionsGridding: "Gridding emissions...",
+	rpc.JobState_Submitted:         "Waiting for the simulation to start...",
+	rpc.JobState_Running:           "Running air quality simulation (this may take several hours)...",
+	rpc.JobState_Downloading:       "Retrieving simulation results...",
+	rpc.JobState_Cached:            "Loading...",
+	rpc.JobState_Failed:            "Simulation failed",
+}
+
+// monitorJob displays the progress of the concentration job associated
+// with sel in the loading area until the job finishes or ctx is canceled.
+func (c *CityAQ) monitorJob(ctx context.Context, sel *selections) {
+	stream, err := c.JobProgress(ctx, &rpc.JobProgressRequest{
+		CityName:   sel.cityName,
+		SourceType: sel.sourceType,
+		Emission:   sel.emission,
+	})
+	if err != nil {
+		return // Progress information is not essential.
+	}
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		text := jobStateText[p.State]
+		if p.Message != "" {
+			text += ": " + p.Message
+		}
+		c.doc.Call("getElementById", "loading_text").Set("innerText", text)
+		if p.State == rpc.JobState_Cached || p.State == rpc.JobState_Failed {
+			return
+		}
+	}
+}
+
 func (c *CityAQ) logError(err error) {
 	s := fmt.Sprintf("<p class=\"text-danger\">%s</p>", err.Error())
 	c.doc.Call("getElementById", "error").Set("innerHTML", s)
@@ -0,0 +1,147 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"syscall/js"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+// gridChunk holds the cells received in one message of a streamed
+// gridded response.
+type gridChunk struct {
+	grid   *rpc.CompactGrid
+	values []float32
+}
+
+// gridCSV builds a CSV file holding the bounds and value of each cell
+// of a grid as its chunks are received.
+type gridCSV struct {
+	b strings.Builder
+
+	// regular describes the grid if it is a regular lattice.
+	regular *rpc.CompactGrid
+
+	// cells is the number of cells received so far.
+	cells int
+}
+
+// newGridCSV returns a gridCSV whose value column has the given name.
+func newGridCSV(valueName string) *gridCSV {
+	o := new(gridCSV)
+	fmt.Fprintf(&o.b, "MinX,MinY,MaxX,MaxY,%s\n", valueName)
+	return o
+}
+
+// add adds the cells in chunk.
+func (g *gridCSV) add(chunk gridChunk) error {
+	if chunk.grid != nil && chunk.grid.Nx > 0 {
+		g.regular = chunk.grid
+	}
+	if g.regular == nil && (chunk.grid == nil || len(chunk.grid.CellBounds) != 4*len(chunk.values)) {
+		return fmt.Errorf("cityaq: missing grid cell bounds for cells %d to %d", g.cells, g.cells+len(chunk.values))
+	}
+	for i, v := range chunk.values {
+		var minX, minY, maxX, maxY float64
+		if r := g.regular; r != nil {
+			n := g.cells + i
+			minX = r.X0 + float64(n%int(r.Nx))*r.Dx
+			minY = r.Y0 + float64(n/int(r.Nx))*r.Dy
+			maxX, maxY = minX+r.Dx, minY+r.Dy
+		} else {
+			b := chunk.grid.CellBounds[4*i : 4*i+4]
+			minX, minY, maxX, maxY = b[0], b[1], b[2], b[3]
+		}
+		fmt.Fprintf(&g.b, "%g,%g,%g,%g,%g\n", minX, minY, maxX, maxY, v)
+	}
+	g.cells += len(chunk.values)
+	return nil
+}
+
+// String returns the contents of the CSV file.
+func (g *gridCSV) String() string { return g.b.String() }
+
+// gridStream returns a function that receives the chunks of the
+// gridded data for sel, which returns io.EOF after the last chunk.
+func (c *CityAQ) gridStream(ctx context.Context, sel *selections) (func() (gridChunk, error), error) {
+	switch sel.impactType {
+	case rpc.ImpactType_Emissions:
+		stream, err := c.StreamGriddedEmissions(ctx, &rpc.GriddedEmissionsRequest{
+			CityName:   sel.cityName,
+			SourceType: sel.sourceType,
+			Emission:   sel.emission,
+			Encoding:   rpc.GridEncoding_CompactEncoding,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return func() (gridChunk, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return gridChunk{}, err
+			}
+			return gridChunk{grid: m.Grid, values: m.Values}, nil
+		}, nil
+	case rpc.ImpactType_Concentrations, rpc.ImpactType_TotalConcentrations:
+		stream, err := c.StreamGriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
+			CityName:   sel.cityName,
+			SourceType: sel.sourceType,
+			Emission:   sel.emission,
+			TotalPM25:  sel.impactType == rpc.ImpactType_TotalConcentrations,
+			Encoding:   rpc.GridEncoding_CompactEncoding,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return func() (gridChunk, error) {
+			m, err := stream.Recv()
+			if err != nil {
+				return gridChunk{}, err
+			}
+			return gridChunk{grid: m.Grid, values: m.Values}, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("cityaq: can't download data for impact type %s", sel.impactType)
+	}
+}
+
+// downloadData streams the gridded data for sel, showing the number of
+// cells received so far in the loading area, and then saves it as a
+// CSV file.
+func (c *CityAQ) downloadData(ctx context.Context, sel *selections) error {
+	recv, err := c.gridStream(ctx, sel)
+	if err != nil {
+		return err
+	}
+	c.startLoading()
+	defer c.stopLoading()
+	g := newGridCSV(sel.impactType.String())
+	for {
+		chunk, err := recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if err := g.add(chunk); err != nil {
+			return err
+		}
+		c.doc.Call("getElementById", "loading_text").Set("innerText",
+			fmt.Sprintf("Downloading data: %d grid cells received...", g.cells))
+	}
+
+	blob := js.Global().Get("Blob").New([]interface{}{g.String()},
+		map[string]interface{}{"type": "text/csv"})
+	url := js.Global().Get("URL").Call("createObjectURL", blob)
+	defer js.Global().Get("URL").Call("revokeObjectURL", url)
+	a := c.doc.Call("createElement", "a")
+	a.Set("href", url)
+	a.Set("download", fmt.Sprintf("%s_%s_%s_%s.csv", sel.cityName, sel.sourceType, sel.emission, sel.impactType))
+	c.doc.Get("body").Call("appendChild", a)
+	a.Call("click")
+	c.doc.Get("body").Call("removeChild", a)
+	return nil
+}
@@ -0,0 +1,106 @@
+package gui
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	caqmock "github.com/ctessum/cityaq/cityaqrpc/mock_cityaqrpc"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGridCSV(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		chunks []gridChunk
+		want   string
+	}{
+		{
+			name: "regular",
+			chunks: []gridChunk{
+				{grid: &rpc.CompactGrid{X0: 0, Y0: 0, Dx: 1, Dy: 2, Nx: 2, Ny: 2}, values: []float32{1, 2}},
+				{values: []float32{3, 4}},
+			},
+			want: "MinX,MinY,MaxX,MaxY,Emissions\n0,0,1,2,1\n1,0,2,2,2\n0,2,1,4,3\n1,2,2,4,4\n",
+		},
+		{
+			name: "irregular",
+			chunks: []gridChunk{
+				{grid: &rpc.CompactGrid{CellBounds: []float64{0, 0, 1, 1}}, values: []float32{1}},
+				{grid: &rpc.CompactGrid{CellBounds: []float64{1, 0, 3, 2}}, values: []float32{2}},
+			},
+			want: "MinX,MinY,MaxX,MaxY,Emissions\n0,0,1,1,1\n1,0,3,2,2\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			g := newGridCSV("Emissions")
+			for _, chunk := range test.chunks {
+				if err := g.add(chunk); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if have := g.String(); have != test.want {
+				t.Errorf("have %q, want %q", have, test.want)
+			}
+		})
+	}
+
+	g := newGridCSV("Emissions")
+	if err := g.add(gridChunk{values: []float32{1}}); err == nil {
+		t.Error("expected an error for cells without bounds")
+	}
+}
+
+func TestGridStream(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	client := caqmock.NewMockCityAQClient(mockCtrl)
+	stream := caqmock.NewMockCityAQ_StreamGriddedConcentrationsClient(mockCtrl)
+
+	client.EXPECT().StreamGriddedConcentrations(
+		gomock.Any(),
+		&rpc.GriddedConcentrationsRequest{
+			CityName:   "city1",
+			SourceType: "roadways",
+			Emission:   rpc.Emission_PM2_5,
+			TotalPM25:  true,
+			Encoding:   rpc.GridEncoding_CompactEncoding,
+		},
+	).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&rpc.GriddedConcentrationsResponse{
+			Grid:   &rpc.CompactGrid{Dx: 1, Dy: 1, Nx: 2, Ny: 1},
+			Values: []float32{1},
+		}, nil),
+		stream.EXPECT().Recv().Return(&rpc.GriddedConcentrationsResponse{Values: []float32{2}}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	c := &CityAQ{CityAQClient: client}
+	recv, err := c.gridStream(context.Background(), &selections{
+		cityName:   "city1",
+		sourceType: "roadways",
+		emission:   rpc.Emission_PM2_5,
+		impactType: rpc.ImpactType_TotalConcentrations,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGridCSV("TotalConcentrations")
+	for {
+		chunk, err := recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.add(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "MinX,MinY,MaxX,MaxY,TotalConcentrations\n0,0,1,1,1\n1,0,2,1,2\n"
+	if have := g.String(); have != want {
+		t.Errorf("have %q, want %q", have, want)
+	}
+}
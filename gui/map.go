@@ -87,6 +87,10 @@ func (c *CityAQ) setMapHeight() {
 
 func (c *CityAQ) updateMap(ctx context.Context, sel *selections) {
 	c.startLoading()
+	ctx, cancel := context.WithCancel(ctx)
+	if sel.impactType != rpc.ImpactType_Emissions {
+		go c.monitorJob(ctx, sel)
+	}
 
 	if c.legendDiv.IsUndefined() {
 		c.legendDiv.Set("innerHTML", "")
@@ -119,6 +123,7 @@ func (c *CityAQ) updateMap(ctx context.Context, sel *selections) {
 	if err != nil {
 		c.logError(err)
 		c.stopLoading()
+		cancel()
 		return
 	}
 
@@ -126,6 +131,7 @@ func (c *CityAQ) updateMap(ctx context.Context, sel *selections) {
 	if err != nil {
 		c.logError(err)
 		c.stopLoading()
+		cancel()
 		return
 	}
 
@@ -187,6 +193,7 @@ func (c *CityAQ) updateMap(ctx context.Context, sel *selections) {
 	var cb js.Func
 	cb = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 		c.stopLoading()
+		cancel()
 		c.mapboxMap.Call("off", "idle", cb)
 		cb.Release()
 		return nil
@@ -268,6 +275,8 @@ func (c *CityAQ) setMapLegend(cm palette.ColorMap, it rpc.ImpactType) {
 		title = "<p class=\"small text-center\">Emissions (kg / kilotonne)</p>"
 	case rpc.ImpactType_Concentrations:
 		title = "<p class=\"small text-center\">PM<sub>2.5</sub> concentrations (μg m<sup>-3</sup> / kilotonne emissions)</p>"
+	case rpc.ImpactType_TotalConcentrations:
+		title = "<p class=\"small text-center\">Total PM<sub>2.5</sub> concentrations (μg m<sup>-3</sup> / kilotonne emissions of each precursor)</p>"
 	}
 	c.legendDiv.Set("innerHTML", title+`<img id="legendimg" class="img-fluid" alt="Legend" src="data:image/png;base64,`+legendStr+`" />`)
 }
@@ -316,6 +325,11 @@ func init() {
 								<td>{{printf "%.2g" .CityIF}}</td>
 								<td>{{printf "%.2g" .TotalIF}}</td>
 							</tr>
+							<tr>
+								<td><a href="#" data-toggle="tooltip" title="Premature deaths per year">Deaths</a></td>
+								<td>{{printf "%.2g" .CityDeaths}}</td>
+								<td>{{printf "%.2g" .TotalDeaths}}</td>
+							</tr>
 						</tbody>
 					</table>
 	      </div>
@@ -325,7 +339,7 @@ func init() {
 }
 
 func (c *CityAQ) summary(sel *selections) error {
-	if sel.impactType != rpc.ImpactType_Concentrations {
+	if sel.impactType != rpc.ImpactType_Concentrations && sel.impactType != rpc.ImpactType_TotalConcentrations {
 		return nil
 	}
 	if c.summaryDiv.IsUndefined() {
@@ -335,6 +349,7 @@ func (c *CityAQ) summary(sel *selections) error {
 		CityName:   sel.cityName,
 		Emission:   sel.emission,
 		SourceType: sel.sourceType,
+		TotalPM25:  sel.impactType == rpc.ImpactType_TotalConcentrations,
 	})
 	if err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 	"syscall/js"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
@@ -32,66 +33,87 @@ func (c *CityAQ) updateCitySelector(ctx context.Context) {
 	if c.citySelector.IsUndefined() {
 		c.citySelector = c.doc.Call("getElementById", "citySelector")
 	}
-	cities, err := c.Cities(ctx, &rpc.CitiesRequest{})
+	cities, err := c.Cities(ctx, &rpc.CitiesRequest{Language: browserLanguage()})
 	if err != nil {
 		c.logError(err)
 		return
 	}
+	// Show the localized names, but use the names in Cities, if
+	// there are any, in requests.
 	names := make([]interface{}, len(cities.Names))
 	for i, n := range cities.Names {
 		names[i] = n
+		if i < len(cities.Cities) {
+			names[i] = cities.Cities[i].Name
+		}
 	}
 	updateSelector(c.doc, c.citySelector, names, cities.Names)
 }
 
+// browserLanguage returns the code of the preferred language of the
+// user, such as "es", without the region.
+func browserLanguage() string {
+	lang := js.Global().Get("navigator").Get("language")
+	if lang.Type() != js.TypeString {
+		return ""
+	}
+	return strings.SplitN(lang.String(), "-", 2)[0]
+}
+
 // updateImpactTypeSelector updates the options of impacts.
-func (c *CityAQ) updateImpactTypeSelector() {
+func (c *CityAQ) updateImpactTypeSelector(impactTypes []*rpc.ImpactTypeInfo) {
 	if c.impactTypeSelector.IsUndefined() {
 		c.impactTypeSelector = c.doc.Call("getElementById", "impactTypeSelector")
 	}
-	updateSelector(c.doc, c.impactTypeSelector, []interface{}{1, 2}, []string{"Emissions", "Concentrations"})
+	values := make([]interface{}, len(impactTypes))
+	text := make([]string, len(impactTypes))
+	for i, it := range impactTypes {
+		values[i] = int(it.ImpactType)
+		text[i] = it.DisplayName
+	}
+	updateSelector(c.doc, c.impactTypeSelector, values, text)
 }
 
 // updateEmissionSelector updates the options of emissions available.
-func (c *CityAQ) updateEmissionSelector() {
+func (c *CityAQ) updateEmissionSelector(emissions []*rpc.EmissionInfo) {
 	if c.emissionSelector.IsUndefined() {
 		c.emissionSelector = c.doc.Call("getElementById", "emissionSelector")
 	}
-	values := make([]interface{}, len(rpc.Emission_value)-1)
-	text := make([]string, len(rpc.Emission_value)-1)
-	for i := 1; i < len(rpc.Emission_value); i++ {
-		n := rpc.Emission_name[int32(i)]
-		values[i-1] = i
-		text[i-1] = n
+	values := make([]interface{}, len(emissions))
+	text := make([]string, len(emissions))
+	for i, e := range emissions {
+		values[i] = int(e.Emission)
+		text[i] = e.DisplayName
 	}
 	updateSelector(c.doc, c.emissionSelector, values, text)
 }
 
 // updateSourceTypeSelector updates the options of source types available.
-func (c *CityAQ) updateSourceTypeSelector() {
+func (c *CityAQ) updateSourceTypeSelector(sourceTypes []*rpc.SourceTypeInfo) {
 	if c.sourceTypeSelector.IsUndefined() {
 		c.sourceTypeSelector = c.doc.Call("getElementById", "sourceTypeSelector")
 	}
-	updateSelector(c.doc, c.sourceTypeSelector,
-		[]interface{}{
-			"electric_gen_egugrid", "population", "residential",
-			"commercial", "industrial", "builtup", "roadways", "roadways_motorway",
-			"roadways_trunk", "roadways_primary", "roadways_secondary", "roadways_tertiary",
-			"railways", "waterways", "bus_routes", "airports", "agricultural",
-		},
-		[]string{
-			"electric_gen_egugrid", "population", "residential",
-			"commercial", "industrial", "builtup", "roadways", "roadways_motorway",
-			"roadways_trunk", "roadways_primary", "roadways_secondary", "roadways_tertiary",
-			"railways", "waterways", "bus_routes", "airports", "agricultural",
-		})
+	values := make([]interface{}, len(sourceTypes))
+	text := make([]string, len(sourceTypes))
+	for i, s := range sourceTypes {
+		values[i] = s.Name
+		text[i] = s.DisplayName
+	}
+	updateSelector(c.doc, c.sourceTypeSelector, values, text)
 }
 
+// updateSelectors updates the options of all of the selectors, using
+// the options that the server reports are available.
 func (c *CityAQ) updateSelectors(ctx context.Context) {
 	c.updateCitySelector(ctx)
-	c.updateImpactTypeSelector()
-	c.updateEmissionSelector()
-	c.updateSourceTypeSelector()
+	opts, err := c.Options(ctx, &rpc.OptionsRequest{})
+	if err != nil {
+		c.logError(err)
+		return
+	}
+	c.updateImpactTypeSelector(opts.ImpactTypes)
+	c.updateEmissionSelector(opts.Emissions)
+	c.updateSourceTypeSelector(opts.SourceTypes)
 }
 
 func selectorValue(selector js.Value) (string, error) {
@@ -62,6 +62,22 @@ func TestCitySelector(t *testing.T) {
 	if html != want {
 		t.Errorf("%v != %v", html, want)
 	}
+
+	// Localized names are shown, but not used as values.
+	client.EXPECT().Cities(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&rpc.CitiesResponse{
+		Names:  []string{"Ciudad de México"},
+		Cities: []*rpc.City{{ID: "mexico_city", Name: "Mexico City"}},
+	}, nil)
+
+	c.updateCitySelector(context.Background())
+	html = c.citySelector.Get("innerHTML").String()
+	want = `<option disabled="" hidden="">-- select an option --</option><option value="Mexico City">Ciudad de México</option>`
+	if html != want {
+		t.Errorf("%v != %v", html, want)
+	}
 }
 
 func TestImpactTypeSelector(t *testing.T) {
@@ -70,7 +86,7 @@ func TestImpactTypeSelector(t *testing.T) {
 	}
 	c.impactTypeSelector = c.doc.Call("createElement", "select")
 
-	c.updateImpactTypeSelector()
+	c.updateImpactTypeSelector([]*rpc.ImpactTypeInfo{{ImpactType: rpc.ImpactType_Emissions, DisplayName: "Emissions"}})
 	html := c.impactTypeSelector.Get("innerHTML").String()
 	want := `<option disabled="" hidden="">-- select an option --</option><option value="1">Emissions</option>`
 	if html != want {
@@ -84,9 +100,12 @@ func TestSourceTypeSelector(t *testing.T) {
 	}
 	c.sourceTypeSelector = c.doc.Call("createElement", "select")
 
-	c.updateSourceTypeSelector()
+	c.updateSourceTypeSelector([]*rpc.SourceTypeInfo{
+		{Name: "electric_gen_egugrid", DisplayName: "Electric gen"},
+		{Name: "roadways", DisplayName: "Roadways"},
+	})
 	html := c.sourceTypeSelector.Get("innerHTML").String()
-	want := `<option disabled="" hidden="">-- select an option --</option><option value="electric_gen">electric_gen</option><option value="residential">residential</option><option value="commercial">commercial</option><option value="industrial">industrial</option><option value="builtup">builtup</option><option value="roadways">roadways</option><option value="railways">railways</option><option value="waterways">waterways</option><option value="busways">busways</option><option value="airports">airports</option><option value="agricultural">agricultural</option>`
+	want := `<option disabled="" hidden="">-- select an option --</option><option value="electric_gen_egugrid">Electric gen</option><option value="roadways">Roadways</option>`
 	if html != want {
 		t.Errorf("%v != %v", html, want)
 	}
@@ -98,14 +117,33 @@ func TestEmissionSelector(t *testing.T) {
 	}
 	c.emissionSelector = c.doc.Call("createElement", "select")
 
-	c.updateEmissionSelector()
+	c.updateEmissionSelector(testOptions.Emissions)
 	html := c.emissionSelector.Get("innerHTML").String()
-	want := `<option disabled="" hidden="">-- select an option --</option><option value="1">PM2_5</option><option value="2">NH3</option><option value="3">NOx</option><option value="4">SOx</option><option value="5">VOC</option>`
+	want := `<option disabled="" hidden="">-- select an option --</option><option value="1">PM2.5</option><option value="2">NH3</option><option value="3">NOx</option><option value="4">SOx</option><option value="5">VOC</option>`
 	if html != want {
 		t.Errorf("%v != %v", html, want)
 	}
 }
 
+// testOptions is a response to the Options RPC.
+var testOptions = &rpc.OptionsResponse{
+	SourceTypes: []*rpc.SourceTypeInfo{
+		{Name: "electric_gen_egugrid", DisplayName: "Electric gen", EGUGrid: true},
+		{Name: "roadways", DisplayName: "Roadways"},
+	},
+	Emissions: []*rpc.EmissionInfo{
+		{Emission: rpc.Emission_PM2_5, DisplayName: "PM2.5"},
+		{Emission: rpc.Emission_NH3, DisplayName: "NH3"},
+		{Emission: rpc.Emission_NOx, DisplayName: "NOx"},
+		{Emission: rpc.Emission_SOx, DisplayName: "SOx"},
+		{Emission: rpc.Emission_VOC, DisplayName: "VOC"},
+	},
+	ImpactTypes: []*rpc.ImpactTypeInfo{
+		{ImpactType: rpc.ImpactType_Emissions, DisplayName: "Emissions"},
+		{ImpactType: rpc.ImpactType_Concentrations, DisplayName: "Concentrations"},
+	},
+}
+
 func changeSelector(selector js.Value, index int) {
 	selector.Set("selectedIndex", index)
 }
@@ -119,6 +157,7 @@ func TestSelectors(t *testing.T) {
 		gomock.Any(), // expect any value for first parameter
 		gomock.Any(), // expect any value for second parameter
 	).Return(&rpc.CitiesResponse{Names: []string{"city1", "city2"}}, nil)
+	client.EXPECT().Options(gomock.Any(), gomock.Any()).Return(testOptions, nil)
 
 	c := &CityAQ{
 		CityAQClient: client,
@@ -137,13 +176,12 @@ func TestSelectors(t *testing.T) {
 	changeSelector(c.impactTypeSelector, 1)
 	changeSelector(c.emissionSelector, 1)
 	changeSelector(c.sourceTypeSelector, 1)
-	changeSelector(c.sourceTypeSelector, 1)
 
 	sel, err := c.selectorValues()
 	if err != nil {
 		t.Fatal(err)
 	}
-	want := &selections{cityName: "city1", impactType: rpc.ImpactType_Emissions, emission: 1, sourceType: "electric_gen"}
+	want := &selections{cityName: "city1", impactType: rpc.ImpactType_Emissions, emission: 1, sourceType: "electric_gen_egugrid"}
 
 	if !reflect.DeepEqual(want, sel) {
 		t.Errorf("%v != %v", sel, want)
@@ -2,10 +2,13 @@ package cityaq
 
 import (
 	"context"
+	"fmt"
+	"math"
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
 	"github.com/ctessum/geom"
 	"github.com/ctessum/geom/index/rtree"
+	"github.com/spatialmodel/inmap/epi"
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/stat"
 )
@@ -13,13 +16,21 @@ import (
 // ImpactSummary returns a summary of the impacts from the given request.
 func (c *CityAQ) ImpactSummary(ctx context.Context, req *rpc.ImpactSummaryRequest) (*rpc.ImpactSummaryResponse, error) {
 	conc, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
-		CityName:   req.CityName,
-		SourceType: req.SourceType,
-		Emission:   req.Emission,
+		CityName:       req.CityName,
+		SourceType:     req.SourceType,
+		Emission:       req.Emission,
+		EmissionAmount: req.EmissionAmount,
+		EmissionUnit:   req.EmissionUnit,
+		TotalPM25:      req.TotalPM25,
+		Scenario:       req.Scenario,
 	})
 	if err != nil {
 		return nil, err
 	}
+	emis, err := impactEmissions(req) // kg/year
+	if err != nil {
+		return nil, err
+	}
 
 	pop, err := c.GriddedPopulation(ctx, &rpc.GriddedPopulationRequest{
 		CityName:   req.CityName,
@@ -33,32 +44,149 @@ func (c *CityAQ) ImpactSummary(ctx context.Context, req *rpc.ImpactSummaryReques
 	if err != nil {
 		return nil, err
 	}
+	hr, err := concentrationResponse(req.ConcentrationResponse)
+	if err != nil {
+		return nil, err
+	}
+	totalDeaths, err := deaths(conc.Concentrations, conc.BaselineTotalPM25, pop.Population, pop.MortalityRate, hr)
+	if err != nil {
+		return nil, failedPrecondition("MORTALITY_RATE", req.CityName, "cityaq: can't calculate deaths: %v", err)
+	}
+	cityDeaths, err := deaths(conc.Concentrations, conc.BaselineTotalPM25, maskedPop, pop.MortalityRate, hr)
+	if err != nil {
+		return nil, failedPrecondition("MORTALITY_RATE", req.CityName, "cityaq: can't calculate deaths: %v", err)
+	}
 	return &rpc.ImpactSummaryResponse{
 		Population:     floats.Sum(pop.Population),
 		CityPopulation: floats.Sum(maskedPop),
 		TotalExposure:  exposure(conc.Concentrations, pop.Population),
 		CityExposure:   exposure(conc.Concentrations, maskedPop),
-		TotalIF:        iF(conc.Concentrations, pop.Population),
-		CityIF:         iF(conc.Concentrations, maskedPop),
+		TotalIF:        iF(conc.Concentrations, pop.Population, emis),
+		CityIF:         iF(conc.Concentrations, maskedPop, emis),
+		TotalDeaths:    totalDeaths,
+		CityDeaths:     cityDeaths,
 	}, nil
 }
 
+// impactEmissions returns the total emissions rate, in kg/year, of
+// all of the pollutants that contribute to the concentrations that
+// the impacts in req are calculated from.
+func impactEmissions(req *rpc.ImpactSummaryRequest) (float64, error) {
+	if req.Scenario != nil {
+		scales, err := scenarioScales(req.Scenario)
+		if err != nil {
+			return 0, err
+		}
+		return totalEmissions(scales), nil
+	}
+	scale, err := emissionsScale(req.EmissionAmount, req.EmissionUnit)
+	if err != nil {
+		return 0, err
+	}
+	if req.TotalPM25 {
+		return totalEmissions(uniformScales(scale)), nil
+	}
+	return totalEmissions(map[rpc.Emission]float64{req.Emission: scale}), nil
+}
+
+// krewski2009 is the log-linear function from Krewski et al. (2009).
+// Unlike epi.Krewski2009 it has no threshold, because the concentrations
+// it is applied to are increments from a single source.
+var krewski2009 = epi.Cox{
+	Beta:  epi.Krewski2009.Beta,
+	Label: "Krewski2009",
+}
+
+// gemm is the Global Exposure Mortality Model for non-communicable diseases
+// and lower respiratory infections (with the Chinese cohort) from:
+//
+// Burnett, R., Chen, H., Szyszkowicz, M., Fann, N., Hubbell, B., Pope, C. A.,
+// … Spadaro, J. V. (2018). Global estimates of mortality associated with
+// long-term exposure to outdoor fine particulate matter. Proceedings of the
+// National Academy of Sciences, 115(38), 9592–9597.
+var gemm = gemmHR{
+	theta:          0.1430,
+	alpha:          1.6,
+	mu:             15.5,
+	nu:             36.8,
+	counterfactual: 2.4,
+	label:          "GEMM",
+}
+
+// gemmHR is a GEMM hazard ratio function. It differs from epi.Nasari,
+// which has a similar form, in that both the log and the logistic terms
+// are functions of the concentration above the counterfactual level.
+type gemmHR struct {
+	theta, alpha, mu, nu float64
+
+	// counterfactual is the concentration (μg m-3) below which there
+	// is no effect.
+	counterfactual float64
+
+	label string
+}
+
+// HR returns the hazard ratio at concentration c (μg m-3).
+func (g gemmHR) HR(c float64) float64 {
+	z := math.Max(0, c-g.counterfactual)
+	return math.Exp(g.theta * math.Log(z/g.alpha+1) / (1 + math.Exp(-(z-g.mu)/g.nu)))
+}
+
+// Name returns the label for this function.
+func (g gemmHR) Name() string { return g.label }
+
+// concentrationResponse returns the hazard ratio function
+// corresponding to cr.
+func concentrationResponse(cr rpc.ConcentrationResponse) (epi.HRer, error) {
+	switch cr {
+	case rpc.ConcentrationResponse_UNKNOWN_CONCENTRATIONRESPONSE, rpc.ConcentrationResponse_Krewski2009:
+		return krewski2009, nil
+	case rpc.ConcentrationResponse_GEMM:
+		return gemm, nil
+	default:
+		return nil, invalidArgument("ConcentrationResponse", "cityaq: invalid concentration-response function %s", cr)
+	}
+}
+
+// deaths returns the number of deaths per year caused by the concentration
+// increment conc (μg m-3) in population pop, where mort is the all-cause
+// mortality rate (deaths per 100,000 people per year), base is the
+// baseline concentration, and hr is the concentration-response function.
+// If base is not available, a baseline concentration of zero is assumed.
+// An error is returned if the population or mortality rate is missing.
+func deaths(conc, base, pop, mort []float64, hr epi.HRer) (float64, error) {
+	if len(mort) != len(conc) || len(pop) != len(conc) {
+		return 0, fmt.Errorf("cityaq: %d grid cells have concentrations, but %d have populations and %d have mortality rates",
+			len(conc), len(pop), len(mort))
+	}
+	var o float64
+	for i, c := range conc {
+		var b float64
+		if len(base) == len(conc) {
+			b = base[i]
+		}
+		// The mortality rate already includes the effects of the baseline
+		// concentration, so we calculate the underlying rate.
+		io := epi.Io(b, hr, mort[i]/100000)
+		o += pop[i] * io * (hr.HR(b+c) - hr.HR(b))
+	}
+	return o, nil
+}
+
 // exposure returns the population-weighted mean of the concentration.
 func exposure(conc, pop []float64) float64 {
 	return stat.Mean(conc, pop)
 }
 
 // iF returns the intake fraction (in ppm) of the given concentration (μg m-3) and
-// population, assuming 1 kilotonne of emissions.
-func iF(conc, pop []float64) float64 {
-	const (
-		br   = 15                  // m3 person-1 day-1
-		emis = 1.0e6 * 1.0e9 / 365 // μg / day
-	)
+// population, for the given emissions rate (kg/year).
+func iF(conc, pop []float64, emis float64) float64 {
+	const br = 15                  // m3 person-1 day-1
+	emisDay := emis * 1.0e9 / 365  // μg / day
 	avgConc := exposure(conc, pop) // μg m-3
 	popSum := floats.Sum(pop)
 	// m3 person-1 day-1 μg m-3 person μg-1 day * 1e6 = ppm
-	return br * avgConc * popSum / emis * 1.0e6
+	return br * avgConc * popSum / emisDay * 1.0e6
 }
 
 // maskPopulation masks the given population grid with the city boundaries.
@@ -97,3 +225,111 @@ func (c *CityAQ) maskPopulation(ctx context.Context, pop *rpc.GriddedPopulationR
 	}
 	return maskedPop, nil
 }
+
+// Damages returns the monetized health damages per tonne of emissions
+// from the given request. The value of a statistical life is adjusted
+// to the country that the city is in using req.IncomeElasticity and the
+// incomes in req or, if they are unset, in CountryIncomeFile, and
+// deaths are discounted using the US EPA cessation lag structure.
+func (c *CityAQ) Damages(ctx context.Context, req *rpc.DamagesRequest) (*rpc.DamagesResponse, error) {
+	if req.VSL <= 0 {
+		return nil, invalidArgument("VSL", "cityaq: VSL must be > 0 but is %g", req.VSL)
+	}
+	if req.DiscountRate < 0 {
+		return nil, invalidArgument("DiscountRate", "cityaq: discount rate must be >= 0 but is %g", req.DiscountRate)
+	}
+	ctry, err := c.country(req.CityName)
+	if err != nil {
+		return nil, err
+	}
+	vsl := req.VSL
+	if req.IncomeElasticity != 0 {
+		income, reference, err := c.damagesIncomes(ctry.Name, req)
+		if err != nil {
+			return nil, err
+		}
+		vsl *= math.Pow(income/reference, req.IncomeElasticity)
+	}
+
+	// ImpactSummary returns impacts for 1 kilotonne of emissions.
+	s, err := c.ImpactSummary(ctx, &rpc.ImpactSummaryRequest{
+		CityName:              req.CityName,
+		SourceType:            req.SourceType,
+		Emission:              req.Emission,
+		ConcentrationResponse: req.ConcentrationResponse,
+	})
+	if err != nil {
+		return nil, err
+	}
+	const tonnes = 1000
+	value := vsl * cessationLag(req.DiscountRate) / tonnes
+	return &rpc.DamagesResponse{
+		Country:      ctry.Name,
+		VSL:          vsl,
+		CityDamages:  s.CityDeaths * value,
+		TotalDamages: s.TotalDeaths * value,
+	}, nil
+}
+
+// damagesIncomes returns the per-capita incomes in the given country
+// and in the reference country for req. Incomes specified in req are
+// used instead of those in CountryIncomeFile.
+func (c *CityAQ) damagesIncomes(country string, req *rpc.DamagesRequest) (income, reference float64, err error) {
+	if req.ReferenceIncome < 0 {
+		return 0, 0, invalidArgument("ReferenceIncome", "cityaq: reference income must be > 0 but is %g", req.ReferenceIncome)
+	}
+	income, ok := req.CountryIncomes[country]
+	reference = req.ReferenceIncome
+	if !ok || reference == 0 {
+		if err := c.loadCountryIncomes(); err != nil {
+			return 0, 0, err
+		}
+		if !ok {
+			income, ok = c.countryIncomes[country]
+		}
+		if reference == 0 {
+			refCountry := c.ReferenceCountry
+			if refCountry == "" {
+				refCountry = defaultReferenceCountry
+			}
+			var refOK bool
+			if reference, refOK = c.countryIncomes[refCountry]; !refOK {
+				return 0, 0, failedPrecondition("INCOME", refCountry, "cityaq: missing income for reference country %s; set ReferenceIncome in the request", refCountry)
+			}
+		}
+	}
+	if !ok {
+		return 0, 0, failedPrecondition("INCOME", country, "cityaq: missing income for country %s; include it in CountryIncomes in the request", country)
+	}
+	if reference <= 0 || income <= 0 {
+		field := "CountryIncomes"
+		if reference <= 0 {
+			field = "ReferenceIncome"
+		}
+		return 0, 0, invalidArgument(field, "cityaq: incomes must be > 0 but reference income is %g and %s income is %g",
+			reference, country, income)
+	}
+	return income, reference, nil
+}
+
+// cessationLag returns the present value of one death caused by a year
+// of exposure, discounted at rate r, using the US EPA 20-year segmented
+// lag structure: 30% of deaths occur in the first year, 50% are evenly
+// distributed over years 2–5, and 20% are evenly distributed over
+// years 6–20.
+func cessationLag(r float64) float64 {
+	var o float64
+	for y := 0; y < 20; y++ {
+		var frac float64
+		switch {
+		case y == 0:
+			frac = 0.3
+		case y < 5:
+			frac = 0.5 / 4
+		default:
+			frac = 0.2 / 15
+		}
+		o += frac / math.Pow(1+r, float64(y))
+	}
+	return o
+}
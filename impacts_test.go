@@ -9,6 +9,9 @@ import (
 
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
 	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"github.com/spatialmodel/inmap/inmaputil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCityAQ_ImpactSummary(t *testing.T) {
@@ -42,3 +45,220 @@ func TestCityAQ_ImpactSummary(t *testing.T) {
 	// response.
 	fmt.Println(s)
 }
+
+func TestDeaths(t *testing.T) {
+	pop := []float64{1.0e5, 2.0e5}
+	mort := []float64{1000, 500} // deaths per 100,000 people per year
+
+	t.Run("Krewski2009", func(t *testing.T) {
+		conc := []float64{10, 0}
+		have, err := deaths(conc, nil, pop, mort, krewski2009)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := 60.0 // 1,000 deaths * 6% increase.
+		if !similar(have, want, 1.0e-8) {
+			t.Errorf("have %g, want %g", have, want)
+		}
+		// The function is log-linear, so the baseline concentration
+		// only affects the result through the underlying mortality rate.
+		haveBase, err := deaths(conc, []float64{5, 5}, pop, mort, krewski2009)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !similar(haveBase, want, 1.0e-8) {
+			t.Errorf("with baseline: have %g, want %g", haveBase, want)
+		}
+	})
+
+	t.Run("GEMM", func(t *testing.T) {
+		conc := []float64{1, 1}
+		if d, err := deaths(conc, nil, pop, mort, gemm); err != nil || d != 0 {
+			t.Errorf("deaths below the counterfactual concentration should be zero but are %g", d)
+		}
+		base := []float64{20, 40}
+		have, err := deaths(conc, base, pop, mort, gemm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want float64
+		for i := range conc {
+			want += pop[i] * mort[i] / 100000 * (gemm.HR(base[i]+conc[i])/gemm.HR(base[i]) - 1)
+		}
+		if !similar(have, want, 1.0e-8) {
+			t.Errorf("have %g, want %g", have, want)
+		}
+		if have <= 0 {
+			t.Errorf("deaths should be positive but are %g", have)
+		}
+	})
+
+	t.Run("missing_mortality", func(t *testing.T) {
+		if _, err := deaths([]float64{10, 0}, nil, pop, nil, krewski2009); err == nil {
+			t.Error("expected an error for missing mortality rates")
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := concentrationResponse(rpc.ConcentrationResponse(100)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestGEMM(t *testing.T) {
+	// Hazard ratios calculated from the GEMM NCD+LRI (with China)
+	// parameters in Burnett et al. (2018): θ=0.1430, α=1.6, μ=15.5,
+	// ν=36.8, and a counterfactual concentration of 2.4 μg m-3.
+	for _, test := range []struct {
+		conc, want float64
+	}{
+		{conc: 0, want: 1},
+		{conc: 2.4, want: 1},
+		{conc: 5, want: 1.0586889489},
+		{conc: 12.4, want: 1.1400551437},
+		{conc: 35, want: 1.3085541210},
+		{conc: 100, want: 1.7039192075},
+	} {
+		if have := gemm.HR(test.conc); !similar(have, test.want, 1.0e-9) {
+			t.Errorf("%g μg m-3: have %.10f, want %.10f", test.conc, have, test.want)
+		}
+	}
+}
+
+func TestCessationLag(t *testing.T) {
+	if l := cessationLag(0); !similar(l, 1, 1.0e-10) {
+		t.Errorf("undiscounted lag should be 1 but is %g", l)
+	}
+	l3 := cessationLag(0.03)
+	if l3 >= 1 || l3 <= 0.8 {
+		t.Errorf("3%% discounted lag %g is out of range", l3)
+	}
+	if l7 := cessationLag(0.07); l7 >= l3 {
+		t.Errorf("7%% discounted lag %g should be less than 3%% lag %g", l7, l3)
+	}
+}
+
+func TestCityAQ_Damages_invalid(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgShapefileDirectory: "testdata",
+		},
+	}
+	for name, req := range map[string]*rpc.DamagesRequest{
+		"no_vsl": {
+			CityName: "Accra Metropolitan",
+		},
+		"missing_income": {
+			CityName:         "Accra Metropolitan",
+			VSL:              9.0e6,
+			IncomeElasticity: 1,
+			ReferenceIncome:  60000,
+			CountryIncomes:   map[string]float64{"Togo": 700},
+		},
+		"missing_reference_income": {
+			CityName:         "Accra Metropolitan",
+			VSL:              9.0e6,
+			IncomeElasticity: 1,
+			CountryIncomes:   map[string]float64{"Ghana": 2000},
+		},
+		"negative_reference_income": {
+			CityName:         "Accra Metropolitan",
+			VSL:              9.0e6,
+			IncomeElasticity: 1,
+			ReferenceIncome:  -1,
+			CountryIncomes:   map[string]float64{"Ghana": 2000},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := c.Damages(context.Background(), req); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestCityAQ_damagesIncomes(t *testing.T) {
+	c := &CityAQ{CountryIncomeFile: "testdata/country_incomes.csv"}
+	for _, test := range []struct {
+		name                      string
+		req                       *rpc.DamagesRequest
+		wantIncome, wantReference float64
+	}{
+		{
+			name:          "server",
+			req:           &rpc.DamagesRequest{},
+			wantIncome:    2202.1,
+			wantReference: 65279.5,
+		},
+		{
+			name:          "income_override",
+			req:           &rpc.DamagesRequest{CountryIncomes: map[string]float64{"Ghana": 2000, "Togo": 700}},
+			wantIncome:    2000,
+			wantReference: 65279.5,
+		},
+		{
+			name:          "reference_override",
+			req:           &rpc.DamagesRequest{ReferenceIncome: 60000},
+			wantIncome:    2202.1,
+			wantReference: 60000,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			income, reference, err := c.damagesIncomes("Ghana", test.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if income != test.wantIncome || reference != test.wantReference {
+				t.Errorf("have %g and %g, want %g and %g", income, reference, test.wantIncome, test.wantReference)
+			}
+		})
+	}
+
+	if _, _, err := c.damagesIncomes("Togo", &rpc.DamagesRequest{}); err == nil {
+		t.Error("expected an error for a country without an income")
+	}
+	c = &CityAQ{CountryIncomeFile: "testdata/country_incomes.csv", ReferenceCountry: "Atlantis"}
+	if _, _, err := c.damagesIncomes("Ghana", &rpc.DamagesRequest{}); err == nil {
+		t.Error("expected an error for a reference country without an income")
+	}
+}
+
+// noMortalityModel is a linearModel whose results have no mortality
+// rates.
+type noMortalityModel struct{}
+
+func (noMortalityModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	if err := (linearModel{}).run(ctx, name, cfg, result, progress); err != nil {
+		return err
+	}
+	result.MortalityRate = nil
+	return nil
+}
+
+func TestCityAQ_ImpactSummary_noMortality(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           noMortalityModel{},
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	_, err := c.ImpactSummary(context.Background(), &rpc.ImpactSummaryRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Emission:   rpc.Emission_PM2_5,
+	})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Errorf("have code %s, want FailedPrecondition: %v", code, err)
+	}
+}
@@ -16,20 +16,18 @@ import (
 )
 
 func main() {
-	sourceTypes := []string{
-		"railways", "electric_gen_egugrid", "population", "residential",
-		"commercial", "industrial", "builtup",
-		"roadways_motorway", "roadways_trunk", "roadways_primary",
-		"roadways_secondary", "roadways_tertiary",
-		"roadways", "waterways",
-		"bus_routes", "airports", "agricultural",
-	}
-
 	ctx := context.Background()
 	conn, err := grpc.Dial("inmap.run:443", grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
 	check(err)
 	client := rpc.NewCityAQClient(conn)
 
+	opts, err := client.Options(ctx, &rpc.OptionsRequest{})
+	check(err)
+	var sourceTypes []string
+	for _, s := range opts.SourceTypes {
+		sourceTypes = append(sourceTypes, s.Name)
+	}
+
 	allCities, err := client.Cities(ctx, &rpc.CitiesRequest{})
 	check(err)
 	var cities []string
@@ -46,7 +44,9 @@ func main() {
 		for _, sourceType := range sourceTypes {
 			for emission := 1; emission <= 5; emission++ {
 				log.Printf("%s; %s; %s", city, sourceType, rpc.Emission(emission))
-				bkf := backoff.NewConstantBackOff(30 * time.Second)
+				// The server resubmits failed model runs, so only retry
+				// a few times to recover from connection problems.
+				bkf := backoff.WithMaxRetries(backoff.NewConstantBackOff(30*time.Second), 3)
 				check(backoff.RetryNotify(
 					func() error {
 						impacts, err := client.ImpactSummary(ctx, &rpc.ImpactSummaryRequest{
@@ -105,6 +105,22 @@ func main() {
 							"total",
 							fmt.Sprint(impacts.TotalIF),
 						}))
+						check(w.Write([]string{
+							city,
+							sourceType,
+							rpc.Emission(emission).String(),
+							"deaths",
+							"city",
+							fmt.Sprint(impacts.CityDeaths),
+						}))
+						check(w.Write([]string{
+							city,
+							sourceType,
+							rpc.Emission(emission).String(),
+							"deaths",
+							"total",
+							fmt.Sprint(impacts.TotalDeaths),
+						}))
 						fmt.Println(impacts)
 						return nil
 					},
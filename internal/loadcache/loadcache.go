@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"sync"
 	"time"
 
@@ -12,7 +11,9 @@ import (
 	rpc "github.com/ctessum/cityaq/cityaqrpc"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 func main() {
@@ -22,13 +23,11 @@ func main() {
 	check(err)
 	client := rpc.NewCityAQClient(conn)
 
-	sourceTypes := []string{
-		"railways", "electric_gen_egugrid", "population", "residential",
-		"commercial", "industrial", "builtup",
-		"roadways_motorway", "roadways_trunk", "roadways_primary",
-		"roadways_secondary", "roadways_tertiary",
-		"roadways", "waterways",
-		"bus_routes", "airports", "agricultural",
+	opts, err := client.Options(ctx, &rpc.OptionsRequest{})
+	check(err)
+	var sourceTypes []string
+	for _, s := range opts.SourceTypes {
+		sourceTypes = append(sourceTypes, s.Name)
 	}
 
 	/*	cities := []string{
@@ -110,9 +109,14 @@ func runQuery(c chan query, wg *sync.WaitGroup) {
 					SourceType: q.sourceType,
 					Emission:   rpc.Emission_PM2_5,
 				})
-				if err != nil && (strings.Contains(err.Error(), "no emissions") || strings.Contains(err.Error(), "larger than max")) {
+				switch status.Code(err) {
+				case codes.FailedPrecondition, codes.ResourceExhausted:
+					// There are no emissions or the result is
+					// too large to send, so retrying won't help.
 					fmt.Println(err)
 					return nil
+				case codes.InvalidArgument, codes.NotFound:
+					return backoff.Permanent(err)
 				}
 				return err
 			},
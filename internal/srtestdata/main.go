@@ -0,0 +1,61 @@
+// Command srtestdata creates the small InMAP source-receptor (SR) matrix
+// in testdata/sr.ncf that is used to test the SR model backend. It runs
+// one InMAP simulation for each ground-level grid cell of the test
+// variable-resolution grid specified in testdata/inmap_config.toml.
+// It should be run from the root directory of the repository, and the
+// simulations require the inmap command to be installed, e.g.,
+// `go install github.com/spatialmodel/inmap/cmd/inmap`.
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spatialmodel/inmap/cloud"
+	"github.com/spatialmodel/inmap/inmaputil"
+)
+
+func main() {
+	dir, err := ioutil.TempDir("", "srtestdata")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := inmaputil.InitializeConfig()
+	cfg.SetConfigFile("testdata/inmap_config.toml")
+	if err := cfg.ReadInConfig(); err != nil {
+		log.Fatal(err)
+	}
+	varGrid, err := inmaputil.VarGridConfig(cfg.Viper)
+	if err != nil {
+		log.Fatal(err)
+	}
+	gridFile := filepath.Join(dir, "grid.gob")
+	if err := inmaputil.Grid(cfg.GetString("InMAPData"), gridFile, varGrid); err != nil {
+		log.Fatal(err)
+	}
+	cfg.Set("static", true)
+	cfg.Set("VariableGridData", gridFile)
+
+	client, err := cloud.NewFakeClient(nil, func(b []byte, err error) {
+		if err != nil {
+			log.Fatalf("%v: %s", err, b)
+		}
+	}, "file://"+dir, cfg.Root, cfg.Viper, cfg.InputFiles(), cfg.OutputFiles())
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), "user", "srtestdata")
+	const jobName = "srtestdata"
+	layers := []int{0}
+	if err := inmaputil.StartSR(ctx, jobName, []string{"run", "steady"}, 1, gridFile, varGrid, 0, -1, layers, cloud.FakeRPCClient{Client: client}, cfg); err != nil {
+		log.Fatal(err)
+	}
+	if err := inmaputil.SaveSR(ctx, jobName, "testdata/sr.ncf", gridFile, varGrid, 0, -1, layers, cloud.FakeRPCClient{Client: client}); err != nil {
+		log.Fatal(err)
+	}
+}
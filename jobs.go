@@ -0,0 +1,430 @@
+package cityaq
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap"
+	"github.com/spatialmodel/inmap/cloud/cloudrpc"
+)
+
+// jobProgress holds the state of a concentration job.
+type jobProgress struct {
+	Key        string
+	CityName   string
+	SourceType string
+	Scenario   map[rpc.Emission]float64
+	State      rpc.JobState
+	Message    string
+	Updated    time.Time
+
+	// run counts the times the job has been started, so that updates
+	// from earlier runs can be ignored.
+	run int
+
+	// changed is closed when the state is updated.
+	changed chan struct{}
+
+	// cancels stop the current run of the job.
+	cancels []context.CancelFunc
+}
+
+// finished returns whether the job is not currently running.
+func (p *jobProgress) finished() bool {
+	switch p.State {
+	case rpc.JobState_UNKNOWN_JOBSTATE, rpc.JobState_Cached, rpc.JobState_Failed, rpc.JobState_Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// toRPC returns an RPC representation of the receiver.
+func (p *jobProgress) toRPC() *rpc.Job {
+	o := &rpc.Job{
+		ID:         p.Key,
+		CityName:   p.CityName,
+		SourceType: p.SourceType,
+		State:      p.State,
+		Message:    p.Message,
+	}
+	if !p.Updated.IsZero() {
+		o.Updated = p.Updated.Unix()
+	}
+	return o
+}
+
+// jobTracker keeps track of the progress of concentration jobs.
+// The zero value is ready to use.
+type jobTracker struct {
+	mu   sync.Mutex
+	jobs map[string]*jobProgress
+
+	// file is the location where jobs that are running are saved,
+	// if any.
+	file string
+
+	// saved holds the jobs that were running when the server last
+	// stopped and have not been resumed yet, by key. They are kept
+	// in file until they are resumed.
+	saved map[string]jobRecord
+
+	// added, if not nil, is closed when a job is first started, so
+	// that jobs can be watched before they are requested.
+	added chan struct{}
+}
+
+// job returns the progress of the job with the given key, creating
+// it if it doesn't exist. The caller must hold t.mu.
+func (t *jobTracker) job(key string) *jobProgress {
+	if t.jobs == nil {
+		t.jobs = make(map[string]*jobProgress)
+	}
+	p, ok := t.jobs[key]
+	if !ok {
+		p = &jobProgress{Key: key, changed: make(chan struct{})}
+		t.jobs[key] = p
+		if t.added != nil {
+			close(t.added)
+			t.added = nil
+		}
+	}
+	return p
+}
+
+// start records that j has started running with the given state,
+// unless it is already running, and returns the number of the run
+// and whether a new run was started. If cancel is not nil, it is
+// called when the run is canceled.
+func (t *jobTracker) start(j *concentrationJob, state rpc.JobState, cancel context.CancelFunc) (int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p := t.job(j.Key())
+	if cancel != nil {
+		p.cancels = append(p.cancels, cancel)
+	}
+	if !p.finished() {
+		return p.run, false
+	}
+	p.run++
+	p.CityName = j.CityName
+	p.SourceType = j.SourceType
+	p.Scenario = j.Scenario
+	p.setState(state, "")
+	t.save()
+	return p.run, true
+}
+
+// update sets the state of the given run of j and notifies any
+// listeners. Updates from earlier runs, or after the run has finished
+// or been canceled, are ignored. Running jobs are only saved when they
+// finish, because the saved records are used to resume jobs rather
+// than to report their progress.
+func (t *jobTracker) update(j *concentrationJob, run int, state rpc.JobState, msg string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.jobs[j.Key()]
+	if !ok || p.run != run || p.finished() {
+		return
+	}
+	p.setState(state, msg)
+	if p.finished() {
+		p.cancels = nil
+		t.save()
+	}
+}
+
+// setState sets the state of the receiver and notifies any listeners.
+// The caller must hold the lock of the associated jobTracker.
+func (p *jobProgress) setState(state rpc.JobState, msg string) {
+	p.State = state
+	p.Message = msg
+	p.Updated = time.Now()
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// watch returns the current state of the job with the given key and
+// a channel that will be closed when the state changes. If the job
+// hasn't been requested, its state is UNKNOWN_JOBSTATE and the channel
+// is closed when any job is started, without keeping track of the key.
+func (t *jobTracker) watch(key string) (jobProgress, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if p, ok := t.jobs[key]; ok {
+		return *p, p.changed
+	}
+	if t.added == nil {
+		t.added = make(chan struct{})
+	}
+	return jobProgress{Key: key}, t.added
+}
+
+// lookup returns the current state of the job with the given key,
+// and whether the job has been requested.
+func (t *jobTracker) lookup(key string) (jobProgress, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.jobs[key]
+	if !ok {
+		return jobProgress{}, false
+	}
+	return *p, true
+}
+
+// cancel stops the job with the given key and marks it as canceled.
+// Jobs that haven't been requested are ignored.
+func (t *jobTracker) cancel(key string) jobProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.jobs[key]
+	if !ok {
+		return jobProgress{Key: key}
+	}
+	for _, cancel := range p.cancels {
+		cancel()
+	}
+	p.cancels = nil
+	p.setState(rpc.JobState_Canceled, "")
+	t.save()
+	return *p
+}
+
+// list returns the state of all jobs that have been requested,
+// sorted by key.
+func (t *jobTracker) list() []jobProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o := make([]jobProgress, 0, len(t.jobs))
+	for _, p := range t.jobs {
+		o = append(o, *p)
+	}
+	sort.Slice(o, func(i, j int) bool { return o[i].Key < o[j].Key })
+	return o
+}
+
+// progressFunc reports the state of a job.
+type progressFunc func(state rpc.JobState, msg string)
+
+// progress returns a function that reports the state of the given
+// run of j.
+func (j *concentrationJob) progress(run int) progressFunc {
+	return func(state rpc.JobState, msg string) {
+		j.c.jobs.update(j, run, state, msg)
+	}
+}
+
+// finish reports the final state of a run of j, given the error, if
+// any, that it ended with.
+func (j *concentrationJob) finish(ctx context.Context, run int, err error) {
+	switch {
+	case err == nil:
+		j.progress(run)(rpc.JobState_Cached, "")
+	case ctx.Err() != nil:
+		j.progress(run)(rpc.JobState_Canceled, err.Error())
+	default:
+		j.progress(run)(rpc.JobState_Failed, err.Error())
+	}
+}
+
+// concentrationResult returns the result of job, either from
+// the cache or by running it. Only runs of the model are recorded
+// by the job tracker.
+func (c *CityAQ) concentrationResult(ctx context.Context, job *concentrationJob) (*inmapResult, error) {
+	if err := c.setupModel(); err != nil {
+		return nil, err
+	}
+	if err := c.setupCache(); err != nil {
+		return nil, err
+	}
+	c.checkCacheLimits(ctx)
+
+	if result, ok := c.memResults.get(job.Key()); ok {
+		c.recordAccess(job.Key())
+		return result, nil
+	}
+	inmapReq := c.cache.NewRequest(ctx, c.storedJob(job))
+	result := new(inmapResult)
+	if err := inmapReq.Result(result); err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, modelFailure(err)
+	}
+	c.memResults.add(job.Key(), result)
+	c.recordAccess(job.Key())
+	return result, nil
+}
+
+// resultCached returns whether the concentration result with the given
+// key is held in memory or in the store.
+func (c *CityAQ) resultCached(ctx context.Context, key string) (bool, error) {
+	if err := c.setupCache(); err != nil {
+		return false, err
+	}
+	if c.memResults.contains(key) {
+		return true, nil
+	}
+	if _, ok := c.store.(*memStore); ok {
+		// Results evicted from memory are still listed.
+		return false, nil
+	}
+	results, err := c.cachedResults(ctx, cacheMeta{Key: key})
+	if err != nil {
+		return false, err
+	}
+	return len(results) > 0, nil
+}
+
+// JobProgress sends the state of the concentration job associated with
+// req to stream each time it changes, until the job is finished. If the
+// job isn't running and its result is already cached, only the Cached
+// state is sent.
+func (c *CityAQ) JobProgress(req *rpc.JobProgressRequest, stream rpc.CityAQ_JobProgressServer) error {
+	var scenario map[rpc.Emission]float64
+	if req.Scenario != nil {
+		var err error
+		scenario, err = scenarioScales(req.Scenario)
+		if err != nil {
+			return err
+		}
+	}
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, scenario)
+	if err != nil {
+		return err
+	}
+	key := job.Key()
+	ctx := stream.Context()
+	if p, ok := c.jobs.lookup(key); !ok || p.finished() {
+		cached, err := c.resultCached(ctx, key)
+		if err != nil {
+			return err
+		}
+		if cached {
+			return stream.Send(&rpc.JobProgressResponse{State: rpc.JobState_Cached})
+		}
+	}
+	sent := false
+	for {
+		p, changed := c.jobs.watch(key)
+		// Other jobs starting don't change the state of
+		// jobs that haven't been requested.
+		if !sent || p.State != rpc.JobState_UNKNOWN_JOBSTATE {
+			if err := stream.Send(&rpc.JobProgressResponse{
+				State:   p.State,
+				Message: p.Message,
+			}); err != nil {
+				return err
+			}
+			sent = true
+		}
+		if p.State != rpc.JobState_UNKNOWN_JOBSTATE && p.finished() {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// SubmitConcentrationJob starts the concentration job associated with req
+// in the background. If the job is already running, its current state
+// is returned.
+func (c *CityAQ) SubmitConcentrationJob(ctx context.Context, req *rpc.SubmitConcentrationJobRequest) (*rpc.Job, error) {
+	var scenario map[rpc.Emission]float64
+	if req.Scenario != nil {
+		var err error
+		scenario, err = scenarioScales(req.Scenario)
+		if err != nil {
+			return nil, err
+		}
+	}
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, scenario)
+	if err != nil {
+		return nil, err
+	}
+	c.startJob(job)
+	p, _ := c.jobs.lookup(job.Key())
+	return p.toRPC(), nil
+}
+
+// startJob runs job in the background, unless it is already running.
+func (c *CityAQ) startJob(job *concentrationJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+	run, started := c.jobs.start(job, rpc.JobState_Queued, cancel)
+	if !started {
+		cancel()
+		return
+	}
+	go func() {
+		defer cancel()
+		// The result may already be cached, in which case the model
+		// isn't run, so record the final state here.
+		_, err := c.concentrationResult(ctx, job)
+		job.finish(ctx, run, err)
+	}()
+}
+
+// GetJob returns the current state of the job with the ID in req. If
+// the job is running in the cloud, its most recent status message
+// is included.
+func (c *CityAQ) GetJob(ctx context.Context, req *rpc.GetJobRequest) (*rpc.Job, error) {
+	p, ok := c.jobs.lookup(req.ID)
+	if !ok {
+		return nil, notFound("ID", "job", req.ID, "cityaq: job %s not found", req.ID)
+	}
+	o := p.toRPC()
+	if c.inmapClient != nil && (p.State == rpc.JobState_Submitted || p.State == rpc.JobState_Running) {
+		status, err := c.inmapClient.Status(cloudContext(ctx), &cloudrpc.JobName{
+			Version: inmap.Version,
+			Name:    req.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		o.Message = status.Message
+	}
+	return o, nil
+}
+
+// CancelJob stops the job with the ID in req, deleting it from the
+// cloud if necessary.
+func (c *CityAQ) CancelJob(ctx context.Context, req *rpc.CancelJobRequest) (*rpc.Job, error) {
+	p, ok := c.jobs.lookup(req.ID)
+	if !ok {
+		return nil, notFound("ID", "job", req.ID, "cityaq: job %s not found", req.ID)
+	}
+	if p.finished() {
+		return nil, failedPrecondition("JOB_STATE", req.ID, "cityaq: job %s is not running", req.ID)
+	}
+	// Stop the job before deleting it from the cloud, so that it isn't
+	// resubmitted when it goes missing.
+	state := p.State
+	p = c.jobs.cancel(req.ID)
+	if c.inmapClient != nil && (state == rpc.JobState_Submitted || state == rpc.JobState_Running) {
+		if _, err := c.inmapClient.Delete(cloudContext(ctx), &cloudrpc.JobName{
+			Version: inmap.Version,
+			Name:    req.ID,
+		}); err != nil {
+			// The job may already have been deleted when it stopped.
+			log.Printf("cityaq: deleting canceled job %s: %v", req.ID, err)
+		}
+	}
+	return p.toRPC(), nil
+}
+
+// ListJobs returns the state of all jobs that have been requested
+// since the server started.
+func (c *CityAQ) ListJobs(ctx context.Context, _ *rpc.ListJobsRequest) (*rpc.ListJobsResponse, error) {
+	o := new(rpc.ListJobsResponse)
+	for _, p := range c.jobs.list() {
+		o.Jobs = append(o.Jobs, p.toRPC())
+	}
+	return o, nil
+}
@@ -0,0 +1,398 @@
+package cityaq
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/requestcache/v3"
+	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"github.com/spatialmodel/inmap/inmaputil"
+	"google.golang.org/grpc"
+)
+
+type fakeJobProgressServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	states chan rpc.JobState
+}
+
+func (s *fakeJobProgressServer) Send(r *rpc.JobProgressResponse) error {
+	s.states <- r.State
+	return nil
+}
+
+func (s *fakeJobProgressServer) Context() context.Context { return s.ctx }
+
+func TestCityAQ_JobProgress(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	req := &rpc.JobProgressRequest{CityName: "Accra Metropolitan", SourceType: "roadways"}
+	job, err := c.newConcentrationJob(req.CityName, req.SourceType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &fakeJobProgressServer{
+		ctx:    context.Background(),
+		states: make(chan rpc.JobState),
+	}
+	errc := make(chan error)
+	go func() {
+		errc <- c.JobProgress(req, s)
+	}()
+
+	var states []rpc.JobState
+	states = append(states, <-s.states)
+	run, _ := c.jobs.start(job, rpc.JobState_Queued, nil)
+	states = append(states, <-s.states)
+	progress := job.progress(run)
+	for _, state := range []rpc.JobState{rpc.JobState_EmissionsGridding,
+		rpc.JobState_Running, rpc.JobState_Cached} {
+		progress(state, "")
+		states = append(states, <-s.states)
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	want := []rpc.JobState{rpc.JobState_UNKNOWN_JOBSTATE, rpc.JobState_Queued,
+		rpc.JobState_EmissionsGridding, rpc.JobState_Running, rpc.JobState_Cached}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("have %v, want %v", states, want)
+	}
+}
+
+func TestCityAQ_JobProgress_cancel(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &fakeJobProgressServer{
+		ctx:    ctx,
+		states: make(chan rpc.JobState, 1),
+	}
+	cancel()
+	err := c.JobProgress(&rpc.JobProgressRequest{CityName: "Accra Metropolitan", SourceType: "roadways"}, s)
+	if err != context.Canceled {
+		t.Errorf("have error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCityAQ_JobProgress_cached(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	req := &rpc.JobProgressRequest{CityName: "Accra Metropolitan", SourceType: "roadways"}
+
+	for _, test := range []struct {
+		name  string
+		c     *CityAQ
+		cache func(c *CityAQ, key string) error
+	}{
+		{
+			name: "memory",
+			c:    &CityAQ{CityGeomDir: "testdata/cities"},
+			cache: func(c *CityAQ, key string) error {
+				if err := c.setupCache(); err != nil {
+					return err
+				}
+				c.memResults.add(key, new(inmapResult))
+				return nil
+			},
+		},
+		{
+			name: "store",
+			c:    &CityAQ{CityGeomDir: "testdata/cities", CacheLoc: dir},
+			cache: func(c *CityAQ, key string) error {
+				return ioutil.WriteFile(filepath.Join(dir, key+requestcache.FileExtension), []byte("result"), 0644)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			job, err := test.c.newConcentrationJob(req.CityName, req.SourceType, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := test.cache(test.c, job.Key()); err != nil {
+				t.Fatal(err)
+			}
+			s := &fakeJobProgressServer{
+				ctx:    context.Background(),
+				states: make(chan rpc.JobState, 2),
+			}
+			if err := test.c.JobProgress(req, s); err != nil {
+				t.Fatal(err)
+			}
+			close(s.states)
+			var states []rpc.JobState
+			for state := range s.states {
+				states = append(states, state)
+			}
+			want := []rpc.JobState{rpc.JobState_Cached}
+			if !reflect.DeepEqual(states, want) {
+				t.Errorf("have %v, want %v", states, want)
+			}
+		})
+	}
+}
+
+func TestJobTracker_watch(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	job, err := c.newConcentrationJob("Accra Metropolitan", "roadways", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tracker jobTracker
+	p, changed := tracker.watch(job.Key())
+	if p.State != rpc.JobState_UNKNOWN_JOBSTATE || len(tracker.jobs) != 0 {
+		t.Errorf("watching should not create jobs: have state %s and %d jobs", p.State, len(tracker.jobs))
+	}
+	tracker.start(job, rpc.JobState_Queued, nil)
+	select {
+	case <-changed:
+	default:
+		t.Error("starting a job should notify watchers")
+	}
+	if p, _ := tracker.watch(job.Key()); p.State != rpc.JobState_Queued {
+		t.Errorf("state: have %s, want %s", p.State, rpc.JobState_Queued)
+	}
+}
+
+// blockingModel is a concentrationModel that runs until its context
+// is canceled.
+type blockingModel struct {
+	started chan struct{}
+}
+
+func (m blockingModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	progress(rpc.JobState_Running, "")
+	close(m.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCityAQ_SubmitConcentrationJob(t *testing.T) {
+	m := blockingModel{started: make(chan struct{})}
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           m,
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+
+	job, err := c.SubmitConcentrationJob(ctx, &rpc.SubmitConcentrationJobRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.State == rpc.JobState_UNKNOWN_JOBSTATE || job.CityName != "Accra Metropolitan" {
+		t.Errorf("invalid job %+v", job)
+	}
+	<-m.started
+
+	job, err = c.GetJob(ctx, &rpc.GetJobRequest{ID: job.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.State != rpc.JobState_Running {
+		t.Errorf("state: have %s, want %s", job.State, rpc.JobState_Running)
+	}
+
+	jobs, err := c.ListJobs(ctx, &rpc.ListJobsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Jobs) != 1 || jobs.Jobs[0].ID != job.ID {
+		t.Errorf("invalid job list %v", jobs.Jobs)
+	}
+
+	job, err = c.CancelJob(ctx, &rpc.CancelJobRequest{ID: job.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.State != rpc.JobState_Canceled {
+		t.Errorf("state: have %s, want %s", job.State, rpc.JobState_Canceled)
+	}
+	if _, err = c.CancelJob(ctx, &rpc.CancelJobRequest{ID: job.ID}); err == nil {
+		t.Error("expected an error when canceling a job that isn't running")
+	}
+	if _, err = c.GetJob(ctx, &rpc.GetJobRequest{ID: "not_a_job"}); err == nil {
+		t.Error("expected an error for a missing job")
+	}
+}
+
+func TestCityAQ_SubmitConcentrationJob_concurrent(t *testing.T) {
+	m := blockingModel{started: make(chan struct{})}
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           m,
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+	req := &rpc.SubmitConcentrationJobRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+	}
+
+	// Only one of the jobs should be started. A second run of the
+	// model would panic when closing m.started again.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.SubmitConcentrationJob(ctx, req); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	<-m.started
+	jobs, err := c.ListJobs(ctx, &rpc.ListJobsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Jobs) != 1 {
+		t.Fatalf("have %d jobs, want 1", len(jobs.Jobs))
+	}
+	if _, err := c.CancelJob(ctx, &rpc.CancelJobRequest{ID: jobs.Jobs[0].ID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCityAQ_concentrationResult_cached(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		JobRegistryFile: filepath.Join(dir, "jobs.json"),
+		model:           linearModel{},
+	}
+	if err := c.jobs.setFile(c.jobRegistryFile()); err != nil {
+		t.Fatal(err)
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+	req := &rpc.GriddedConcentrationsRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Emission:   rpc.Emission_PM2_5,
+	}
+
+	if _, err := c.GriddedConcentrations(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err := c.ListJobs(ctx, &rpc.ListJobsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Jobs) != 1 || jobs.Jobs[0].State != rpc.JobState_Cached {
+		t.Fatalf("invalid jobs %v", jobs.Jobs)
+	}
+
+	// Results from the cache should not be recorded.
+	if err := os.Remove(c.JobRegistryFile); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GriddedConcentrations(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(c.JobRegistryFile); !os.IsNotExist(err) {
+		t.Errorf("job registry should not be saved for cached results: %v", err)
+	}
+	if _, ok := c.jobs.lookup("not_a_job"); ok || len(c.jobs.jobs) != 1 {
+		t.Errorf("lookups should not create jobs: have %d", len(c.jobs.jobs))
+	}
+}
+
+func TestCityAQ_CancelJob_synchronous(t *testing.T) {
+	m := blockingModel{started: make(chan struct{})}
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           m,
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+	ctx := context.Background()
+
+	errc := make(chan error)
+	go func() {
+		_, err := c.GriddedConcentrations(ctx, &rpc.GriddedConcentrationsRequest{
+			CityName:   "Accra Metropolitan",
+			SourceType: "electric_gen_egugrid",
+			Emission:   rpc.Emission_PM2_5,
+		})
+		errc <- err
+	}()
+	<-m.started
+
+	jobs, err := c.ListJobs(ctx, &rpc.ListJobsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Jobs) != 1 {
+		t.Fatalf("invalid job list %v", jobs.Jobs)
+	}
+	id := jobs.Jobs[0].ID
+	if _, err := c.CancelJob(ctx, &rpc.CancelJobRequest{ID: id}); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; err == nil {
+		t.Error("expected an error from a canceled model run")
+	}
+	job, err := c.GetJob(ctx, &rpc.GetJobRequest{ID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.State != rpc.JobState_Canceled {
+		t.Errorf("state: have %s, want %s", job.State, rpc.JobState_Canceled)
+	}
+}
@@ -0,0 +1,185 @@
+package cityaq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap"
+	"github.com/spatialmodel/inmap/cloud/cloudrpc"
+)
+
+// jobRecord is the saved representation of a running job.
+type jobRecord struct {
+	Key        string
+	CityName   string
+	SourceType string
+	Scenario   map[rpc.Emission]float64 `json:",omitempty"`
+	State      rpc.JobState
+	Updated    time.Time
+}
+
+// jobRegistryFile returns the location where running jobs should be
+// saved, or an empty string if they should not be saved.
+func (c *CityAQ) jobRegistryFile() string {
+	if c.JobRegistryFile != "" {
+		return os.ExpandEnv(c.JobRegistryFile)
+	}
+	if c.CacheLoc == "" || strings.Contains(c.CacheLoc, "://") && !strings.HasPrefix(c.CacheLoc, "file://") {
+		return ""
+	}
+	return filepath.Join(strings.TrimPrefix(c.CacheLoc, "file://"), "cityaq_jobs.json")
+}
+
+// save writes the jobs that are running, and those that have not been
+// resumed yet, to t.file, if it is set. The caller must hold t.mu.
+func (t *jobTracker) save() {
+	if t.file == "" {
+		return
+	}
+	records := make([]jobRecord, 0)
+	for key, r := range t.saved {
+		if _, ok := t.jobs[key]; !ok {
+			records = append(records, r)
+		}
+	}
+	for _, p := range t.jobs {
+		if p.finished() {
+			continue
+		}
+		records = append(records, jobRecord{
+			Key:        p.Key,
+			CityName:   p.CityName,
+			SourceType: p.SourceType,
+			Scenario:   p.Scenario,
+			State:      p.State,
+			Updated:    p.Updated,
+		})
+	}
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		log.Printf("cityaq: saving job registry: %v", err)
+		return
+	}
+	// Write to a temporary file first so that the registry isn't
+	// corrupted if the server stops while writing.
+	tmp := t.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		log.Printf("cityaq: saving job registry: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, t.file); err != nil {
+		log.Printf("cityaq: saving job registry: %v", err)
+	}
+}
+
+// setFile sets the location where running jobs are saved and reads
+// the jobs that were saved there, so that they are kept until they
+// are resumed.
+func (t *jobTracker) setFile(file string) error {
+	records, err := readJobRecords(file)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.file = file
+	t.saved = make(map[string]jobRecord, len(records))
+	for _, r := range records {
+		t.saved[r.Key] = r
+	}
+	return nil
+}
+
+// resumable returns the saved jobs that have not been resumed yet,
+// sorted by key.
+func (t *jobTracker) resumable() []jobRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	records := make([]jobRecord, 0, len(t.saved))
+	for _, r := range t.saved {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Key < records[j].Key })
+	return records
+}
+
+// forget removes the saved job with the given key, which has been
+// resumed or can't be resumed, from the jobs that are kept in t.file.
+func (t *jobTracker) forget(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.saved, key)
+}
+
+// readJobRecords returns the jobs saved in file.
+func readJobRecords(file string) ([]jobRecord, error) {
+	if file == "" {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("cityaq: loading job registry: %w", err)
+	}
+	var records []jobRecord
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, fmt.Errorf("cityaq: loading job registry: %w", err)
+	}
+	return records, nil
+}
+
+// ResumeJobs resumes the concentration jobs that were running when
+// the server last stopped. Jobs that are still running in the cloud
+// are monitored until they finish, rather than being resubmitted.
+// Jobs that were running in-process are restarted.
+func (c *CityAQ) ResumeJobs(ctx context.Context) error {
+	if err := c.setupModel(); err != nil {
+		return err
+	}
+	for _, r := range c.jobs.resumable() {
+		c.resumeJob(ctx, r)
+		// The job is now tracked, if it could be resumed.
+		c.jobs.forget(r.Key)
+	}
+	return nil
+}
+
+// resumeJob resumes the saved job r.
+func (c *CityAQ) resumeJob(ctx context.Context, r jobRecord) {
+	job, err := c.newConcentrationJob(r.CityName, r.SourceType, r.Scenario)
+	if err != nil {
+		log.Printf("cityaq: not resuming job %s: %v", r.Key, err)
+		return
+	}
+	if job.Key() != r.Key {
+		log.Printf("cityaq: not resuming job %s: configuration has changed", r.Key)
+		return
+	}
+	if c.inmapClient != nil {
+		status, err := c.inmapClient.Status(cloudContext(ctx), &cloudrpc.JobName{
+			Version: inmap.Version,
+			Name:    r.Key,
+		})
+		if err != nil || status.Status == cloudrpc.Status_Failed || status.Status == cloudrpc.Status_Missing {
+			msg := fmt.Sprintf("job could not be resumed after server restart: %v", err)
+			if err == nil {
+				msg = fmt.Sprintf("job could not be resumed after server restart: %s %s", status.Status, status.Message)
+			}
+			run, _ := c.jobs.start(job, rpc.JobState_Queued, nil)
+			job.progress(run)(rpc.JobState_Failed, msg)
+			return
+		}
+	}
+	log.Printf("cityaq: resuming job %s", r.Key)
+	c.startJob(job)
+}
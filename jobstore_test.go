@@ -0,0 +1,149 @@
+package cityaq
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+func TestJobTracker_save(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &CityAQ{CityGeomDir: "testdata/cities", CacheLoc: "file://" + dir}
+	if err := c.jobs.setFile(c.jobRegistryFile()); err != nil {
+		t.Fatal(err)
+	}
+
+	running, err := c.newConcentrationJob("Accra Metropolitan", "roadways",
+		map[rpc.Emission]float64{rpc.Emission_PM2_5: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	finished, err := c.newConcentrationJob("Accra Metropolitan", "railways", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.jobs.start(running, rpc.JobState_Running, nil)
+	run, _ := c.jobs.start(finished, rpc.JobState_Running, nil)
+	finished.progress(run)(rpc.JobState_Cached, "")
+
+	if _, err := os.Stat(filepath.Join(dir, "cityaq_jobs.json")); err != nil {
+		t.Fatal(err)
+	}
+	records, err := readJobRecords(c.jobRegistryFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("have %d records, want 1", len(records))
+	}
+	r := records[0]
+	if r.Key != running.Key() || r.State != rpc.JobState_Running ||
+		!reflect.DeepEqual(r.Scenario, running.Scenario) {
+		t.Errorf("invalid record %+v", r)
+	}
+}
+
+func TestCityAQ_jobRegistryFile(t *testing.T) {
+	for loc, want := range map[string]string{
+		"":                "",
+		"gs://bucket/dir": "",
+		"file://tmp":      filepath.Join("tmp", "cityaq_jobs.json"),
+		"tmp":             filepath.Join("tmp", "cityaq_jobs.json"),
+	} {
+		c := &CityAQ{CacheLoc: loc}
+		if have := c.jobRegistryFile(); have != want {
+			t.Errorf("%s: have %s, want %s", loc, have, want)
+		}
+	}
+	c := &CityAQ{CacheLoc: "gs://bucket/dir", JobRegistryFile: "jobs.json"}
+	if have := c.jobRegistryFile(); have != "jobs.json" {
+		t.Errorf("have %s, want jobs.json", have)
+	}
+}
+
+func TestCityAQ_setupModel_jobRegistryWarning(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	for _, test := range []struct {
+		c    *CityAQ
+		warn bool
+	}{
+		{c: &CityAQ{CacheLoc: "gs://bucket/dir", Backend: LocalBackend}, warn: true},
+		{c: &CityAQ{CacheLoc: "s3://bucket/dir", Backend: LocalBackend}, warn: true},
+		{c: &CityAQ{CacheLoc: "s3://bucket/dir", Backend: LocalBackend, JobRegistryFile: filepath.Join(os.TempDir(), "cityaq_jobs_nonexistent.json")}},
+	} {
+		buf.Reset()
+		if err := test.c.setupModel(); err != nil {
+			t.Fatal(err)
+		}
+		if warned := strings.Contains(buf.String(), "JobRegistryFile"); warned != test.warn {
+			t.Errorf("%s with registry %q: have warning %v, want %v: %s",
+				test.c.CacheLoc, test.c.JobRegistryFile, warned, test.warn, buf.String())
+		}
+	}
+}
+
+func TestJobTracker_setFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cityaq_jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "jobs.json")
+	saved := `[{"Key":"savedjob","CityName":"Accra Metropolitan","SourceType":"railways","State":5}]`
+	if err := ioutil.WriteFile(file, []byte(saved), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &CityAQ{CityGeomDir: "testdata/cities", JobRegistryFile: file}
+	if err := c.jobs.setFile(c.jobRegistryFile()); err != nil {
+		t.Fatal(err)
+	}
+	// Saving a new job before the saved job has been resumed should
+	// keep the saved job.
+	job, err := c.newConcentrationJob("Accra Metropolitan", "roadways", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.jobs.start(job, rpc.JobState_Running, nil)
+	records, err := readJobRecords(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, r := range records {
+		keys = append(keys, r.Key)
+	}
+	sort.Strings(keys)
+	if want := []string{job.Key(), "savedjob"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("saved jobs: have %v, want %v", keys, want)
+	}
+	if r := c.jobs.resumable(); len(r) != 1 || r[0].Key != "savedjob" {
+		t.Errorf("invalid resumable jobs %v", r)
+	}
+
+	// The saved job can't be resumed because its key doesn't match.
+	c.modelSetupOnce.Do(func() error { return nil })
+	if err := c.ResumeJobs(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if r := c.jobs.resumable(); len(r) != 0 {
+		t.Errorf("jobs were not resumed: %v", r)
+	}
+}
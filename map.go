@@ -72,11 +72,55 @@ type MapSpecification struct {
 	ImpactType rpc.ImpactType
 	Emission   rpc.Emission
 	SourceType string
+
+	// Scenario optionally specifies an emissions scenario, as in
+	// rpc.GriddedConcentrationsRequest.
+	Scenario *rpc.EmissionScenario
 }
 
 // Key returns a unique identifier for the receiver.
 func (ms *MapSpecification) Key() string {
-	return fmt.Sprintf("%s_%d_%d_%s", ms.CityName, ms.ImpactType, ms.Emission, ms.SourceType)
+	k := fmt.Sprintf("%s_%d_%d_%s", ms.CityName, ms.ImpactType, ms.Emission, ms.SourceType)
+	if ms.Scenario != nil {
+		k += "_" + formatScenario(ms.Scenario)
+	}
+	return k
+}
+
+// parseScenario parses an emissions scenario in the format
+// Emission:Amount:Unit,..., for example "PM2_5:500:t/yr,NOx:2:kt/yr".
+// The unit may be omitted.
+func parseScenario(s string) (*rpc.EmissionScenario, error) {
+	o := new(rpc.EmissionScenario)
+	for _, e := range strings.Split(s, ",") {
+		parts := strings.SplitN(e, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("map request invalid scenario emission %q", e)
+		}
+		pol, ok := rpc.Emission_value[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("map request invalid scenario pollutant %q", parts[0])
+		}
+		amount, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("map request invalid scenario amount %q", parts[1])
+		}
+		pe := &rpc.PollutantEmission{Emission: rpc.Emission(pol), Amount: amount}
+		if len(parts) == 3 {
+			pe.Unit = parts[2]
+		}
+		o.Emissions = append(o.Emissions, pe)
+	}
+	return o, nil
+}
+
+// formatScenario formats s in the format read by parseScenario.
+func formatScenario(s *rpc.EmissionScenario) string {
+	parts := make([]string, len(s.Emissions))
+	for i, e := range s.Emissions {
+		parts[i] = fmt.Sprintf("%s:%g:%s", e.Emission, e.Amount, e.Unit)
+	}
+	return strings.Join(parts, ",")
 }
 
 func queryString(u *url.URL, q url.Values, k string) (string, error) {
@@ -100,7 +144,8 @@ func queryInt(u *url.URL, q url.Values, k string) (int, error) {
 }
 
 // parseRequest parses a request of the type
-// xxx?x={x}&y={y}&z={z}&c={city}&it={ImpactType}&em={Emission}&st={SourceType}
+// xxx?x={x}&y={y}&z={z}&c={city}&it={ImpactType}&em={Emission}&st={SourceType}&sc={Scenario},
+// where sc is optional and is in the format read by parseScenario.
 func parseMapRequest(u *url.URL) (*MapSpecification, int, int, int, error) {
 	q := u.Query()
 	ms := new(MapSpecification)
@@ -136,12 +181,28 @@ func parseMapRequest(u *url.URL) (*MapSpecification, int, int, int, error) {
 	if err != nil {
 		return nil, -1, -1, -1, err
 	}
+
+	if sc := q.Get("sc"); sc != "" {
+		ms.Scenario, err = parseScenario(sc)
+		if err != nil {
+			return nil, -1, -1, -1, err
+		}
+	}
 	return ms, x, y, z, nil
 }
 
 // Layers returns the vector tile layers associated with ms.
 func (s *MapTileServer) Layers(ctx context.Context, ms *MapSpecification) (mvt.Layers, error) {
-	resultI, err := s.cache.NewRequest(ctx, ms, ms.Key()).Result()
+	// Include the version of the city boundary in the key so that
+	// layers are recalculated when the boundary changes, and refer to
+	// the city by name in case ms refers to it by ID.
+	cf, err := s.c.cityFile(ms.CityName)
+	if err != nil {
+		return nil, err
+	}
+	msName := *ms
+	msName.CityName = cf.name
+	resultI, err := s.cache.NewRequest(ctx, &msName, msName.Key()+"_"+cf.version).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -175,11 +236,13 @@ func (s *MapTileServer) layers(ctx context.Context, r interface{}) (interface{},
 		if err != nil {
 			return nil, err
 		}
-	case rpc.ImpactType_Concentrations:
+	case rpc.ImpactType_Concentrations, rpc.ImpactType_TotalConcentrations:
 		req := &rpc.GriddedConcentrationsRequest{
 			CityName:   ms.CityName,
 			Emission:   ms.Emission,
 			SourceType: ms.SourceType,
+			TotalPM25:  ms.ImpactType == rpc.ImpactType_TotalConcentrations,
+			Scenario:   ms.Scenario,
 		}
 		var err error
 		dataLayer, err = s.c.concentrationsMapData(ctx, req)
@@ -187,7 +250,7 @@ func (s *MapTileServer) layers(ctx context.Context, r interface{}) (interface{},
 			return nil, err
 		}
 	default:
-		return nil, fmt.Errorf("invalid impact type %s", ms.ImpactType.String())
+		return nil, invalidArgument("ImpactType", "cityaq: invalid impact type %s", ms.ImpactType.String())
 	}
 
 	cityGeom, err := s.c.geojsonGeometry(ms.CityName)
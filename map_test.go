@@ -42,6 +42,21 @@ func TestParseMapRequest(t *testing.T) {
 	if z != 12 {
 		t.Errorf("z: %d != %d", z, 12)
 	}
+
+	u.RawQuery += "&sc=" + url.QueryEscape("PM2_5:500:t/yr,NOx:2")
+	newMS, _, _, _, err = parseMapRequest(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := formatScenario(newMS.Scenario), "PM2_5:500:t/yr,NOx:2:"; have != want {
+		t.Errorf("scenario: %s != %s", have, want)
+	}
+	for _, sc := range []string{"PM2_5", "CO2:1", "PM2_5:x"} {
+		u.RawQuery = "x=1&y=1&z=1&c=a&it=1&em=1&st=s&sc=" + url.QueryEscape(sc)
+		if _, _, _, _, err := parseMapRequest(u); err == nil {
+			t.Errorf("expected an error for scenario %q", sc)
+		}
+	}
 }
 
 func TestMapTileServer_ServeHTTP(t *testing.T) {
@@ -0,0 +1,311 @@
+package cityaq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap"
+	"github.com/spatialmodel/inmap/cloud"
+	"github.com/spatialmodel/inmap/cloud/cloudrpc"
+	"github.com/spatialmodel/inmap/inmaputil"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Options for the Backend field of CityAQ.
+const (
+	// CloudBackend runs InMAP simulations as jobs in a Kubernetes cluster,
+	// or in a simulated cluster when not running in Kubernetes.
+	CloudBackend = "cloud"
+
+	// LocalBackend runs InMAP simulations in the same process as the server.
+	LocalBackend = "local"
+
+	// SRBackend estimates concentrations using the precomputed InMAP
+	// source-receptor (SR) matrix specified by the SRMatrixFile field,
+	// which is much faster than running a full simulation.
+	SRBackend = "sr"
+)
+
+// concentrationModel runs a steady-state air quality model.
+type concentrationModel interface {
+	// run runs a simulation with the given name using the
+	// configuration in cfg, which specifies the emissions shapefile
+	// to use, and stores the output in result. The state of the
+	// simulation is reported using progress.
+	run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error
+}
+
+// setupModel initializes the concentration model, if it hasn't been
+// initialized already.
+func (c *CityAQ) setupModel() error {
+	return c.modelSetupOnce.Do(func() error {
+		if err := c.modelSetup(); err != nil {
+			return dependencyUnavailable(dependencyModel, err)
+		}
+		return nil
+	})
+}
+
+// modelSetup initializes the concentration model specified by
+// the Backend field of the receiver.
+func (c *CityAQ) modelSetup() error {
+	// Read the saved jobs before any are started, so that they
+	// aren't lost when the registry is next saved.
+	registry := c.jobRegistryFile()
+	if registry == "" {
+		log.Printf("cityaq: running jobs will not be saved or resumed after the server restarts; " +
+			"set JobRegistryFile to save them when CacheLoc is not a local directory")
+	}
+	if err := c.jobs.setFile(registry); err != nil {
+		return err
+	}
+	switch c.Backend {
+	case "", CloudBackend:
+		if err := c.cloudSetup(); err != nil {
+			return err
+		}
+		c.model = &cloudModel{
+			client:          c.inmapClient,
+			pollInterval:    c.JobPollInterval,
+			maxPollInterval: c.MaxJobPollInterval,
+			maxDuration:     c.MaxJobDuration,
+			retries:         c.JobRetries,
+		}
+	case LocalBackend:
+		c.model = localModel{}
+	case SRBackend:
+		if c.SRMatrixFile == "" {
+			return fmt.Errorf("cityaq: SRMatrixFile must be specified when using the %s backend", SRBackend)
+		}
+		file := os.ExpandEnv(c.SRMatrixFile)
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("cityaq: problem with SR matrix file: %w", err)
+		}
+		c.model = srModel{file: file}
+	default:
+		return fmt.Errorf("cityaq: invalid model backend %q", c.Backend)
+	}
+	return nil
+}
+
+func (c *CityAQ) cloudSetup() error {
+	if strings.HasPrefix(c.CacheLoc, "s3://") {
+		// The cloud client writes job output to CacheLoc.
+		if err := c.S3.setCloudEnv(); err != nil {
+			return err
+		}
+	}
+	cfg := inmaputil.InitializeConfig()
+
+	if os.ExpandEnv("${KUBERNETES_SERVICE_HOST}") == "" {
+		log.Println("NOT IN KUBERNETES CLUSTER")
+		var err error
+		c.inmapClient, err = cloud.NewFakeClient(nil, func(b []byte, err error) {
+			fmt.Println(string(b))
+			if err != nil {
+				fmt.Println("ERROR", err)
+			}
+		}, c.CacheLoc, cfg.Root, cfg.Viper, cfg.InputFiles(), cfg.OutputFiles())
+		if err != nil {
+			return fmt.Errorf("failed to initialize fake InMAP server: %w", err)
+		}
+		return nil
+	}
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load in-cluster Kubernetes configuration: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Kubernetes: %w", err)
+	}
+
+	c.inmapClient, err = cloud.NewClient(clientset, cfg.Root, cfg.Viper, c.CacheLoc, cfg.InputFiles(), cfg.OutputFiles())
+	if err != nil {
+		return fmt.Errorf("failed to initialize InMAP server: %w", err)
+	}
+
+	return nil
+}
+
+// cloudContext returns a copy of ctx with the user name that the cloud
+// client requires.
+func cloudContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, "user", "cityaq_user")
+}
+
+// Default intervals between checks of the status of cloud jobs.
+const (
+	defaultJobPollInterval    = time.Minute
+	defaultMaxJobPollInterval = 10 * time.Minute
+)
+
+// cloudModel runs InMAP simulations using a cloud client.
+type cloudModel struct {
+	client *cloud.Client
+
+	// pollInterval is the initial interval between checks of the job
+	// status, which doubles after each check up to maxPollInterval.
+	pollInterval, maxPollInterval time.Duration
+
+	// maxDuration is the maximum amount of time to wait for a job to
+	// finish, including resubmissions. If it is zero, there is no limit.
+	maxDuration time.Duration
+
+	// retries is the number of times to resubmit a job that fails.
+	retries int
+}
+
+// jobFailedError is returned when a cloud job fails or goes missing.
+type jobFailedError struct {
+	name    string
+	status  cloudrpc.Status
+	message string
+}
+
+func (e jobFailedError) Error() string {
+	return fmt.Sprintf("job %s error: %s, %s", e.name, e.status, e.message)
+}
+
+func (m *cloudModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	in, err := cloud.JobSpec(
+		cfg.Root, cfg.Viper,
+		name,
+		cfg.GetStringSlice("cmds"),
+		cfg.InputFiles(),
+		int32(cfg.GetInt("memory_gb")),
+	)
+	if err != nil {
+		return err
+	}
+	if m.maxDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.maxDuration)
+		defer cancel()
+	}
+	jobName := &cloudrpc.JobName{
+		Version: inmap.Version,
+		Name:    name,
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = m.runJob(ctx, in, jobName, progress)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			// Don't leave the job running if nobody is waiting for it.
+			m.client.Delete(cloudContext(context.Background()), jobName)
+			return fmt.Errorf("cityaq: job %s: %w", name, ctx.Err())
+		}
+		if _, ok := err.(jobFailedError); !ok || attempt >= m.retries {
+			return err
+		}
+		log.Printf("cityaq: resubmitting job after failure (%d of %d): %v", attempt+1, m.retries, err)
+	}
+
+	progress(rpc.JobState_Downloading, "")
+	output, err := m.client.Output(ctx, jobName)
+	if err != nil {
+		return err
+	}
+
+	if err := inmapOutputToResult(output, result); err != nil {
+		return err
+	}
+	if _, err := m.client.Delete(ctx, jobName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// runJob submits the job specified by in and waits for it to finish.
+// If the job is already running, for example because it was submitted
+// before the server restarted, it is monitored rather than resubmitted.
+// Failed jobs are resubmitted by the cloud client.
+func (m *cloudModel) runJob(ctx context.Context, in *cloudrpc.JobSpec, jobName *cloudrpc.JobName, progress progressFunc) error {
+	status, err := m.client.Status(ctx, jobName)
+	if err != nil || status.Status == cloudrpc.Status_Failed || status.Status == cloudrpc.Status_Missing {
+		if _, err := m.client.RunJob(ctx, in); err != nil {
+			return err
+		}
+	}
+	progress(rpc.JobState_Submitted, "")
+
+	interval := m.pollInterval
+	if interval <= 0 {
+		interval = defaultJobPollInterval
+	}
+	maxInterval := m.maxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxJobPollInterval
+	}
+	for {
+		status, err := m.client.Status(ctx, jobName)
+		if err != nil {
+			return err
+		}
+		switch status.Status {
+		case cloudrpc.Status_Complete:
+			return nil
+		case cloudrpc.Status_Failed, cloudrpc.Status_Missing:
+			return jobFailedError{name: jobName.Name, status: status.Status, message: status.Message}
+		case cloudrpc.Status_Running:
+			progress(rpc.JobState_Running, status.Message)
+		default:
+			progress(rpc.JobState_Submitted, status.Message)
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// localModel runs InMAP simulations in the current process.
+// Simulations cannot be canceled once they have started.
+type localModel struct{}
+
+func (localModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	dir, err := ioutil.TempDir("", "cityaq_output")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	outputFile := filepath.Join(dir, "OutputFile.shp")
+	cfg.Set("OutputFile", outputFile)
+	cfg.Set("LogFile", filepath.Join(dir, name+".log"))
+
+	progress(rpc.JobState_Running, "")
+	cfg.Root.SetArgs(cfg.GetStringSlice("cmds"))
+	if err := cfg.Root.Execute(); err != nil {
+		return fmt.Errorf("cityaq: running InMAP job %s: %w", name, err)
+	}
+	return readInmapOutput(outputFile, result)
+}
+
+// srModel estimates concentrations in the current process using an
+// InMAP source-receptor matrix stored in a NetCDF file.
+type srModel struct {
+	file string
+}
+
+func (m srModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	cfg.Set("SR.OutputFile", m.file)
+	cfg.Set("cmds", []string{"srpredict"})
+	return localModel{}.run(ctx, name, cfg, result, progress)
+}
@@ -0,0 +1,85 @@
+package cityaq
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestCityAQ_modelSetup(t *testing.T) {
+	c := &CityAQ{Backend: LocalBackend}
+	if err := c.modelSetup(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.model.(localModel); !ok {
+		t.Errorf("wrong model type %T", c.model)
+	}
+
+	c = &CityAQ{Backend: "quantum"}
+	if err := c.modelSetup(); err == nil {
+		t.Error("expected an error for an invalid backend")
+	}
+}
+
+func TestCityAQ_modelSetup_sr(t *testing.T) {
+	c := &CityAQ{Backend: SRBackend}
+	if err := c.modelSetup(); err == nil {
+		t.Error("expected an error for a missing SR matrix file")
+	}
+
+	c = &CityAQ{Backend: SRBackend, SRMatrixFile: "testdata/sr.ncf"}
+	if err := c.modelSetup(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.model.(srModel); !ok {
+		t.Errorf("wrong model type %T", c.model)
+	}
+}
+
+func TestCityAQ_modelSetup_s3Endpoint(t *testing.T) {
+	c := &CityAQ{
+		CacheLoc: "s3://bucket/cache",
+		S3:       S3Config{Endpoint: "http://localhost:9000"},
+	}
+	if err := c.modelSetup(); err == nil {
+		t.Error("expected an error for an S3 endpoint that the cloud client can't use")
+	}
+}
+
+func TestCityAQ_GriddedConcentrations_sr(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		Backend:         SRBackend,
+		SRMatrixFile:    "testdata/sr.ncf",
+	}
+	conc, err := c.GriddedConcentrations(context.Background(), &rpc.GriddedConcentrationsRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Emission:   rpc.Emission_PM2_5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The concentrations are on the ground-level grid of the SR matrix.
+	if len(conc.Concentrations) != 12 {
+		t.Errorf("have %d grid cells, want 12", len(conc.Concentrations))
+	}
+	concSum := floats.Sum(conc.Concentrations)
+	wantConcSum := 0.029018391445
+	if !similar(concSum, wantConcSum, 1.0e-8) {
+		t.Errorf("concentration sum: %g != %g", concSum, wantConcSum)
+	}
+}
@@ -0,0 +1,386 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// openAPIPath is the location of the OpenAPI document under restPrefix.
+const openAPIPath = "openapi.json"
+
+var (
+	openAPIOnce sync.Once
+	openAPIDoc  []byte
+)
+
+// openAPIDocument returns an OpenAPI 3 document describing the JSON
+// HTTP API and the /maptile endpoint. The schemas are generated from
+// the message definitions in cityaq.proto.
+func openAPIDocument() []byte {
+	openAPIOnce.Do(func() {
+		g := &openAPIGenerator{schemas: make(map[string]interface{})}
+		doc := map[string]interface{}{
+			"openapi": "3.0.3",
+			"info": map[string]interface{}{
+				"title":       "CityAQ API",
+				"description": "Estimates of the air quality impacts of activities in cities.",
+				"version":     "v1",
+			},
+			"paths": g.paths(),
+			"components": map[string]interface{}{
+				"schemas": g.schemas,
+				"securitySchemes": map[string]interface{}{
+					"admin": map[string]interface{}{
+						"type":   "http",
+						"scheme": "bearer",
+					},
+				},
+			},
+		}
+		g.schemas["Error"] = map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"error": map[string]interface{}{"type": "string"},
+				"code":  map[string]interface{}{"type": "string"},
+				"details": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"type": "object"},
+				},
+			},
+		}
+		var err error
+		openAPIDoc, err = json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			panic(err) // The document only holds maps, slices and strings.
+		}
+	})
+	return openAPIDoc
+}
+
+// serveOpenAPI writes the OpenAPI document to w.
+func serveOpenAPI(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPIDocument())
+}
+
+// pathParamDescriptions describes the path parameters in restPathParams.
+var pathParamDescriptions = map[string]string{
+	"{name}": "City name or ID.",
+	"{id}":   "Job ID.",
+	"{key}":  "Cache key of the result.",
+}
+
+// openAPIGenerator creates an OpenAPI document.
+type openAPIGenerator struct {
+	// schemas holds the schemas of the messages and enums
+	// that have been referenced.
+	schemas map[string]interface{}
+}
+
+// paths returns the OpenAPI path items.
+func (g *openAPIGenerator) paths() map[string]interface{} {
+	paths := make(map[string]interface{})
+	for _, rt := range restRoutes {
+		path := restPrefix + rt.pattern
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[path] = item
+		}
+		item[strings.ToLower(rt.method)] = g.operation(rt)
+	}
+	paths["/maptile"] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"summary": "Get a vector map tile.",
+			"parameters": []interface{}{
+				queryParam("x", "Tile column.", intSchema(), true),
+				queryParam("y", "Tile row.", intSchema(), true),
+				queryParam("z", "Zoom level.", intSchema(), true),
+				queryParam("c", "City name or ID.", stringSchema(), true),
+				queryParam("it", "Impact type.", enumNumberSchema(rpc.ImpactType(0).Descriptor()), true),
+				queryParam("em", "Emitted pollutant.", enumNumberSchema(rpc.Emission(0).Descriptor()), true),
+				queryParam("st", "Source type.", stringSchema(), true),
+				queryParam("sc", "Emissions scenario, as comma-separated Emission:Amount:Unit triples, for example PM2_5:500:t/yr,NOx:2:kt/yr.", stringSchema(), false),
+			},
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "A Mapbox vector tile, gzip-compressed if the request accepts it.",
+					"content": map[string]interface{}{
+						"application/vnd.mapbox-vector-tile": map[string]interface{}{
+							"schema": map[string]interface{}{"type": "string", "format": "binary"},
+						},
+					},
+				},
+				"default": errorResponse(),
+			},
+		},
+	}
+	paths[readinessPath] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"summary": "Report whether the server's dependencies can be loaded.",
+			"responses": map[string]interface{}{
+				"200": readinessResponse("All dependencies are ready."),
+				"503": readinessResponse("At least one dependency is not ready."),
+			},
+		},
+	}
+	paths[restPrefix+openAPIPath] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"summary": "Get this document.",
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "The OpenAPI document.",
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": map[string]interface{}{"type": "object"},
+						},
+					},
+				},
+			},
+		},
+	}
+	return paths
+}
+
+// operation returns the OpenAPI operation for rt.
+func (g *openAPIGenerator) operation(rt restRoute) map[string]interface{} {
+	req := rt.newReq().ProtoReflect().Descriptor()
+	var params []interface{}
+	pathFields := make(map[string]bool)
+	for _, p := range strings.Split(rt.pattern, "/") {
+		if field, ok := restPathParams[p]; ok {
+			pathFields[field] = true
+			params = append(params, map[string]interface{}{
+				"name":        strings.Trim(p, "{}"),
+				"in":          "path",
+				"description": pathParamDescriptions[p],
+				"required":    true,
+				"schema":      stringSchema(),
+			})
+		}
+	}
+	op := map[string]interface{}{
+		"summary":     rt.doc,
+		"operationId": strings.ToLower(rt.method) + operationName(rt.pattern),
+	}
+	if rt.method == http.MethodPost {
+		op["requestBody"] = map[string]interface{}{
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": g.ref(req),
+				},
+			},
+		}
+	} else {
+		fields := req.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if pathFields[string(fd.Name())] {
+				continue
+			}
+			if fd.Kind() == protoreflect.MessageKind {
+				params = append(params, jsonQueryParam(string(fd.Name()), g.fieldSchema(fd)))
+				continue
+			}
+			params = append(params, queryParam(string(fd.Name()), "", g.fieldSchema(fd), false))
+		}
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	contentType := "application/json"
+	if rt.stream != nil {
+		contentType = "application/x-ndjson"
+	}
+	op["responses"] = map[string]interface{}{
+		"200": map[string]interface{}{
+			"description": "Success.",
+			"content": map[string]interface{}{
+				contentType: map[string]interface{}{
+					"schema": g.ref(rt.resp.ProtoReflect().Descriptor()),
+				},
+			},
+		},
+		"default": errorResponse(),
+	}
+	if strings.HasPrefix(rt.pattern, "cache") {
+		op["security"] = []interface{}{map[string]interface{}{"admin": []interface{}{}}}
+	}
+	return op
+}
+
+// operationName returns a name for the operation at the given path
+// pattern, for example "CitiesNameEmissions" for
+// "cities/{name}/emissions".
+func operationName(pattern string) string {
+	var o string
+	for _, p := range strings.Split(pattern, "/") {
+		p = strings.Trim(p, "{}")
+		o += strings.ToUpper(p[:1]) + p[1:]
+	}
+	return o
+}
+
+// ref returns a reference to the schema for md, adding the schema
+// to the receiver if necessary.
+func (g *openAPIGenerator) ref(md protoreflect.MessageDescriptor) map[string]interface{} {
+	name := string(md.Name())
+	if _, ok := g.schemas[name]; !ok {
+		g.schemas[name] = nil // Prevent infinite recursion.
+		properties := make(map[string]interface{})
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			properties[fd.JSONName()] = g.fieldSchema(fd)
+		}
+		g.schemas[name] = map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+		}
+	}
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// enumRef returns a reference to the schema for ed, adding the schema
+// to the receiver if necessary.
+func (g *openAPIGenerator) enumRef(ed protoreflect.EnumDescriptor) map[string]interface{} {
+	name := string(ed.Name())
+	if _, ok := g.schemas[name]; !ok {
+		var values []interface{}
+		for i := 0; i < ed.Values().Len(); i++ {
+			values = append(values, string(ed.Values().Get(i).Name()))
+		}
+		g.schemas[name] = map[string]interface{}{
+			"type": "string",
+			"enum": values,
+		}
+	}
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// fieldSchema returns the schema of fd in the protobuf JSON mapping.
+func (g *openAPIGenerator) fieldSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	if fd.IsMap() {
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.fieldSchema(fd.MapValue()),
+		}
+	}
+	var s map[string]interface{}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		s = map[string]interface{}{"type": "boolean"}
+	case protoreflect.StringKind:
+		s = stringSchema()
+	case protoreflect.BytesKind:
+		s = map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.DoubleKind:
+		s = map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.FloatKind:
+		s = map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers are represented as strings.
+		s = map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.EnumKind:
+		s = g.enumRef(fd.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		s = g.ref(fd.Message())
+	default:
+		s = intSchema()
+	}
+	if fd.IsList() {
+		return map[string]interface{}{"type": "array", "items": s}
+	}
+	return s
+}
+
+func queryParam(name, description string, schema map[string]interface{}, required bool) map[string]interface{} {
+	p := map[string]interface{}{
+		"name":   name,
+		"in":     "query",
+		"schema": schema,
+	}
+	if description != "" {
+		p["description"] = description
+	}
+	if required {
+		p["required"] = true
+	}
+	return p
+}
+
+// jsonQueryParam returns an optional query parameter whose value is
+// the protobuf JSON encoding of a message or map field.
+func jsonQueryParam(name string, schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"in":          "query",
+		"description": "JSON-encoded.",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": schema},
+		},
+	}
+}
+
+func stringSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "string"}
+}
+
+func intSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "integer", "format": "int32"}
+}
+
+// enumNumberSchema returns the schema for an enum that is represented by
+// its number rather than its name.
+func enumNumberSchema(ed protoreflect.EnumDescriptor) map[string]interface{} {
+	var numbers []interface{}
+	var names []string
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		numbers = append(numbers, int32(v.Number()))
+		names = append(names, fmt.Sprintf("%d: %s", v.Number(), v.Name()))
+	}
+	s := intSchema()
+	s["enum"] = numbers
+	s["description"] = string(ed.Name()) + " (" + strings.Join(names, ", ") + ")"
+	return s
+}
+
+func readinessResponse(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"ready": map[string]interface{}{"type": "boolean"},
+						"dependencies": map[string]interface{}{
+							"type":                 "object",
+							"additionalProperties": stringSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func errorResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"description": "An error.",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+			},
+		},
+	}
+}
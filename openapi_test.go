@@ -0,0 +1,77 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	h := restHandler{c: &CityAQ{}}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	var doc struct {
+		OpenAPI    string                                       `json:"openapi"`
+		Paths      map[string]map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		t.Errorf("openapi version %q", doc.OpenAPI)
+	}
+	for _, rt := range restRoutes {
+		if _, ok := doc.Paths[restPrefix+rt.pattern][strings.ToLower(rt.method)]; !ok {
+			t.Errorf("missing %s %s", rt.method, rt.pattern)
+		}
+	}
+	tile, ok := doc.Paths["/maptile"]["get"]
+	if !ok {
+		t.Fatal("missing /maptile")
+	}
+	var params []string
+	for _, p := range tile["parameters"].([]interface{}) {
+		params = append(params, p.(map[string]interface{})["name"].(string))
+	}
+	if have, want := strings.Join(params, ","), "x,y,z,c,it,em,st,sc"; have != want {
+		t.Errorf("maptile parameters: have %s, want %s", have, want)
+	}
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			params, _ := op["parameters"].([]interface{})
+			for _, p := range params {
+				if p := p.(map[string]interface{}); p["in"] == "path" && p["description"] == "" {
+					t.Errorf("%s %s: path parameter %s isn't described", method, path, p["name"])
+				}
+			}
+		}
+	}
+	var scenario map[string]interface{}
+	for _, p := range doc.Paths[restPrefix+"cities/{name}/impacts"]["get"]["parameters"].([]interface{}) {
+		if p := p.(map[string]interface{}); p["name"] == "Scenario" {
+			scenario = p
+		}
+	}
+	if scenario == nil {
+		t.Error("missing Scenario query parameter")
+	} else if _, ok := scenario["content"].(map[string]interface{})["application/json"]; !ok {
+		t.Errorf("Scenario parameter isn't described as JSON: %v", scenario)
+	}
+	for _, ref := range regexp.MustCompile(`"#/components/schemas/(\w+)"`).FindAllStringSubmatch(w.Body.String(), -1) {
+		if _, ok := doc.Components.Schemas[ref[1]]; !ok {
+			t.Errorf("unresolved reference to %s", ref[1])
+		}
+	}
+	if _, ok := doc.Components.Schemas["CitiesResponse"]; !ok {
+		t.Error("missing CitiesResponse schema")
+	}
+}
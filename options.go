@@ -0,0 +1,169 @@
+package cityaq
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+// emissionInfo describes the pollutants that can be emitted.
+var emissionInfo = []*rpc.EmissionInfo{
+	{Emission: rpc.Emission_PM2_5, DisplayName: "PM2.5", Description: "Primary fine particulate matter", Units: "kg/year"},
+	{Emission: rpc.Emission_NH3, DisplayName: "NH3", Description: "Ammonia", Units: "kg/year"},
+	{Emission: rpc.Emission_NOx, DisplayName: "NOx", Description: "Oxides of nitrogen", Units: "kg/year"},
+	{Emission: rpc.Emission_SOx, DisplayName: "SOx", Description: "Oxides of sulfur", Units: "kg/year"},
+	{Emission: rpc.Emission_VOC, DisplayName: "VOC", Description: "Volatile organic compounds", Units: "kg/year"},
+}
+
+// impactTypeInfo describes the types of impacts that can be requested.
+var impactTypeInfo = []*rpc.ImpactTypeInfo{
+	{
+		ImpactType:  rpc.ImpactType_Emissions,
+		DisplayName: "Emissions",
+		Description: "Emissions per kilotonne of total emissions",
+		Units:       "kg/year",
+	},
+	{
+		ImpactType:  rpc.ImpactType_Concentrations,
+		DisplayName: "Concentrations",
+		Description: "PM2.5 concentrations caused by a kilotonne of emissions of the selected pollutant",
+		Units:       "μg/m³",
+	},
+	{
+		ImpactType:  rpc.ImpactType_TotalConcentrations,
+		DisplayName: "Total PM2.5 concentrations",
+		Description: "PM2.5 concentrations caused by a kilotonne of emissions of each precursor",
+		Units:       "μg/m³",
+	},
+}
+
+// Options returns the source types in the surrogate specifications
+// of the receiver's SpatialConfig, along with the pollutants and
+// impact types that can be requested.
+func (c *CityAQ) Options(ctx context.Context, _ *rpc.OptionsRequest) (*rpc.OptionsResponse, error) {
+	sourceTypes, err := c.sourceTypes()
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.OptionsResponse{
+		SourceTypes: sourceTypes,
+		Emissions:   emissionInfo,
+		ImpactTypes: impactTypeInfo,
+	}, nil
+}
+
+// sourceTypes returns the source types in the SMOKE and OSM surrogate
+// specifications, in the order they are listed. Source types that
+// are in both specifications are only listed once.
+func (c *CityAQ) sourceTypes() ([]*rpc.SourceTypeInfo, error) {
+	var o []*rpc.SourceTypeInfo
+	index := make(map[string]*rpc.SourceTypeInfo)
+	add := func(name, description string, tags []*rpc.OSMTag) {
+		s, ok := index[name]
+		if !ok {
+			s = &rpc.SourceTypeInfo{
+				Name:        name,
+				DisplayName: sourceTypeDisplayName(name),
+				EGUGrid:     egugridEmissions(name),
+			}
+			index[name] = s
+			o = append(o, s)
+		}
+		if s.Description == "" {
+			s.Description = description
+		}
+		s.OSMTags = append(s.OSMTags, tags...)
+	}
+	if c.SrgSpecSMOKE != "" {
+		f, err := os.Open(os.ExpandEnv(c.SrgSpecSMOKE))
+		if err != nil {
+			return nil, fmt.Errorf("cityaq: reading SMOKE surrogate specification: %w", err)
+		}
+		defer f.Close()
+		r := csv.NewReader(f)
+		r.Comment = '#'
+		r.FieldsPerRecord = -1
+		records, err := r.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("cityaq: reading SMOKE surrogate specification: %w", err)
+		}
+		// The first record is the header.
+		for i := 1; i < len(records); i++ {
+			rec := records[i]
+			if len(rec) < 14 {
+				return nil, fmt.Errorf("cityaq: SMOKE surrogate specification line %d has %d fields; it should have at least 14", i+1, len(rec))
+			}
+			description := strings.TrimSpace(rec[13])
+			if description == "" && len(rec) > 14 {
+				description = strings.TrimSpace(rec[14])
+			}
+			add(surrogateCode(rec[1], rec[2]), description, nil)
+		}
+	}
+	if c.SrgSpecOSM != "" {
+		f, err := os.Open(os.ExpandEnv(c.SrgSpecOSM))
+		if err != nil {
+			return nil, fmt.Errorf("cityaq: reading OSM surrogate specification: %w", err)
+		}
+		defer f.Close()
+		var specs []struct {
+			Name, Code string
+			Tags       map[string][]string
+		}
+		if err := json.NewDecoder(f).Decode(&specs); err != nil {
+			return nil, fmt.Errorf("cityaq: reading OSM surrogate specification: %w", err)
+		}
+		for _, spec := range specs {
+			keys := make([]string, 0, len(spec.Tags))
+			for k := range spec.Tags {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			tags := make([]*rpc.OSMTag, len(keys))
+			for i, k := range keys {
+				tags[i] = &rpc.OSMTag{Key: k, Values: spec.Tags[k]}
+			}
+			add(surrogateCode(spec.Name, spec.Code), osmDescription(tags), tags)
+		}
+	}
+	return o, nil
+}
+
+// surrogateCode returns the surrogate code, which is the value that
+// is used as the source type, or the name if there is no code.
+func surrogateCode(name, code string) string {
+	if code = strings.TrimSpace(code); code != "" {
+		return code
+	}
+	return strings.TrimSpace(name)
+}
+
+// sourceTypeDisplayName returns a human-readable version of the given
+// source type, for example "Roadways motorway" for "roadways_motorway".
+func sourceTypeDisplayName(sourceType string) string {
+	s := strings.Replace(strings.TrimSuffix(sourceType, "_egugrid"), "_", " ", -1)
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// osmDescription returns a description of the OpenStreetMap features
+// with the given tags.
+func osmDescription(tags []*rpc.OSMTag) string {
+	var s []string
+	for _, t := range tags {
+		if len(t.Values) == 0 {
+			s = append(s, t.Key)
+		} else {
+			s = append(s, t.Key+"="+strings.Join(t.Values, "|"))
+		}
+	}
+	return "OpenStreetMap features tagged " + strings.Join(s, ", ")
+}
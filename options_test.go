@@ -0,0 +1,48 @@
+package cityaq
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+)
+
+func TestCityAQ_Options(t *testing.T) {
+	c := &CityAQ{
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:   "testdata/srgspec_osm.json",
+			SrgSpecSMOKE: "testdata/srgspec_smoke.csv",
+		},
+	}
+	o, err := c.Options(context.Background(), &rpc.OptionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range o.SourceTypes {
+		names = append(names, s.Name)
+	}
+	if want := []string{"electric_gen_egugrid", "roadways", "airports"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("source types: have %v, want %v", names, want)
+	}
+	egu := o.SourceTypes[0]
+	if !egu.EGUGrid || egu.DisplayName != "Electric gen" || egu.Description != "electric generation" || len(egu.OSMTags) != 0 {
+		t.Errorf("wrong EGU source type: %v", egu)
+	}
+	airports := o.SourceTypes[2]
+	if airports.EGUGrid || len(airports.OSMTags) != 2 || airports.OSMTags[0].Key != "aeroway" ||
+		airports.OSMTags[1].Key != "building" || !reflect.DeepEqual(airports.OSMTags[1].Values, []string{"hangar"}) {
+		t.Errorf("wrong airports source type: %v", airports)
+	}
+	if want := "OpenStreetMap features tagged aeroway, building=hangar"; airports.Description != want {
+		t.Errorf("airports description: have %q, want %q", airports.Description, want)
+	}
+	if len(o.Emissions) != len(rpc.Emission_name)-1 {
+		t.Errorf("have %d emissions, want %d", len(o.Emissions), len(rpc.Emission_name)-1)
+	}
+	if len(o.ImpactTypes) != len(rpc.ImpactType_name)-1 {
+		t.Errorf("have %d impact types, want %d", len(o.ImpactTypes), len(rpc.ImpactType_name)-1)
+	}
+}
@@ -0,0 +1,94 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/status"
+)
+
+// retryOnce performs an initialization action until it succeeds.
+// Unlike sync.Once, a failed action is retried the next time Do is
+// called, so that temporary problems don't make the server unusable
+// until it is restarted.
+type retryOnce struct {
+	done uint32
+	mu   sync.Mutex
+}
+
+// Do calls f if it hasn't previously succeeded, and returns its error.
+func (o *retryOnce) Do(f func() error) error {
+	if atomic.LoadUint32(&o.done) == 1 {
+		return nil
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.done == 1 {
+		return nil
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	atomic.StoreUint32(&o.done, 1)
+	return nil
+}
+
+// Dependency names used in readiness reports and errors.
+const (
+	dependencyCities    = "cities"
+	dependencyCountries = "countries"
+	dependencyIncomes   = "country incomes"
+	dependencyCache     = "cache"
+	dependencyModel     = "model"
+)
+
+// Readiness reports whether the dependencies of the receiver, which
+// are loaded when first needed, can be loaded. Dependencies that
+// failed to load previously are loaded again. The returned map has
+// the name of each dependency as the key and its error, or nil if
+// it is ready, as the value.
+func (c *CityAQ) Readiness() map[string]error {
+	return map[string]error{
+		dependencyCities:    c.loadCities(),
+		dependencyCountries: c.loadCountries(),
+		dependencyIncomes:   c.loadCountryIncomes(),
+		dependencyCache:     c.setupCache(),
+		dependencyModel:     c.setupModel(),
+	}
+}
+
+// readinessPath is the HTTP route that reports readiness.
+const readinessPath = "/readyz"
+
+// readinessReport is the body of a response from readinessHandler.
+type readinessReport struct {
+	Ready bool `json:"ready"`
+
+	// Dependencies holds "ok" or an error message for each dependency.
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// readinessHandler responds with status 200 if all of the dependencies
+// of c are ready, or 503 otherwise, along with a readinessReport.
+type readinessHandler struct {
+	c *CityAQ
+}
+
+func (h readinessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := readinessReport{Ready: true, Dependencies: make(map[string]string)}
+	for name, err := range h.c.Readiness() {
+		if err != nil {
+			report.Ready = false
+			report.Dependencies[name] = status.Convert(err).Message()
+		} else {
+			report.Dependencies[name] = "ok"
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
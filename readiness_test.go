@@ -0,0 +1,74 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRetryOnce(t *testing.T) {
+	var o retryOnce
+	var calls int
+	f := func() error {
+		calls++
+		if calls == 1 {
+			return errors.New("temporary problem")
+		}
+		return nil
+	}
+	if err := o.Do(f); err == nil {
+		t.Error("first call should fail")
+	}
+	if err := o.Do(f); err != nil {
+		t.Errorf("second call: %v", err)
+	}
+	if err := o.Do(f); err != nil {
+		t.Errorf("third call: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("f called %d times; it should be called twice", calls)
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	c := &CityAQ{
+		CityGeomDir: "testdata/nonexistent",
+		Backend:     LocalBackend,
+	}
+	c.SrgShapefileDirectory = "testdata"
+	h := readinessHandler{c: c}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, readinessPath, nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status %d; want %d", w.Code, http.StatusServiceUnavailable)
+	}
+	var report readinessReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Ready || report.Dependencies[dependencyCities] == "ok" || report.Dependencies[dependencyCountries] != "ok" {
+		t.Errorf("wrong report: %+v", report)
+	}
+
+	// The city boundaries should be loaded once they are available.
+	c.CityGeomDir = "testdata/cities"
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, readinessPath, nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("status %d; want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+}
+
+func TestCityAQ_Readiness_incomes(t *testing.T) {
+	c := &CityAQ{CountryIncomeFile: "testdata/nonexistent.csv"}
+	if err := c.Readiness()[dependencyIncomes]; err == nil {
+		t.Error("a missing income file should be reported")
+	}
+	c = &CityAQ{CountryIncomeFile: "testdata/country_incomes.csv"}
+	if err := c.Readiness()[dependencyIncomes]; err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,628 @@
+package cityaq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// restPrefix is the path prefix of the JSON HTTP API.
+const restPrefix = "/api/v1/"
+
+// restRoute maps an HTTP method and path to a CityAQ method.
+type restRoute struct {
+	method string
+
+	// pattern holds the path after restPrefix. Segments in braces
+	// are parameters that are copied to the field of the request
+	// given by restPathParams.
+	pattern string
+
+	// doc is a short description of the route.
+	doc string
+
+	newReq func() proto.Message
+
+	// resp is a nil response message, which is used to describe
+	// the response.
+	resp proto.Message
+
+	// Exactly one of call and stream is set. Responses from
+	// streaming methods are sent as newline-delimited JSON.
+	call   func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error)
+	stream func(c *CityAQ, req proto.Message, s *restStream) error
+}
+
+// restPathParams maps path parameters to request fields.
+var restPathParams = map[string]string{
+	"{name}": "CityName",
+	"{id}":   "ID",
+	"{key}":  "Key",
+}
+
+// restQueryAliases maps short query parameter names to request fields.
+// Other query parameters must match a field name, ignoring case.
+var restQueryAliases = map[string]string{
+	"city":   "CityName",
+	"source": "SourceType",
+}
+
+var restRoutes = []restRoute{
+	{
+		method: http.MethodGet, pattern: "cities",
+		doc:    "List the available cities.",
+		resp:   (*rpc.CitiesResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.CitiesRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.Cities(ctx, req.(*rpc.CitiesRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "options",
+		doc:    "List the source types, pollutants and impact types that can be requested.",
+		resp:   (*rpc.OptionsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.OptionsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.Options(ctx, req.(*rpc.OptionsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/geometry",
+		doc:    "Get the boundary of a city.",
+		resp:   (*rpc.CityGeometryResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.CityGeometryRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.CityGeometry(ctx, req.(*rpc.CityGeometryRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/emissions",
+		doc:    "Get gridded emissions for a city and source type.",
+		resp:   (*rpc.GriddedEmissionsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedEmissionsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.GriddedEmissions(ctx, req.(*rpc.GriddedEmissionsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/emissions/stream",
+		doc:    "Stream gridded emissions in chunks, as newline-delimited JSON.",
+		resp:   (*rpc.GriddedEmissionsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedEmissionsRequest) },
+		stream: func(c *CityAQ, req proto.Message, s *restStream) error {
+			return c.StreamGriddedEmissions(req.(*rpc.GriddedEmissionsRequest), griddedEmissionsRESTStream{s})
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/emissions/bounds",
+		doc:    "Get the bounds of the emissions grid.",
+		resp:   (*rpc.EmissionsGridBoundsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.EmissionsGridBoundsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.EmissionsGridBounds(ctx, req.(*rpc.EmissionsGridBoundsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/concentrations",
+		doc:    "Get gridded PM2.5 concentrations.",
+		resp:   (*rpc.GriddedConcentrationsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedConcentrationsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.GriddedConcentrations(ctx, req.(*rpc.GriddedConcentrationsRequest))
+		},
+	},
+	{
+		// POST allows an emissions scenario to be specified
+		// in the request body.
+		method: http.MethodPost, pattern: "cities/{name}/concentrations",
+		doc:    "Get gridded PM2.5 concentrations for an emissions scenario given in the request body.",
+		resp:   (*rpc.GriddedConcentrationsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedConcentrationsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.GriddedConcentrations(ctx, req.(*rpc.GriddedConcentrationsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/concentrations/stream",
+		doc:    "Stream gridded PM2.5 concentrations in chunks, as newline-delimited JSON.",
+		resp:   (*rpc.GriddedConcentrationsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedConcentrationsRequest) },
+		stream: func(c *CityAQ, req proto.Message, s *restStream) error {
+			return c.StreamGriddedConcentrations(req.(*rpc.GriddedConcentrationsRequest), griddedConcentrationsRESTStream{s})
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/population",
+		doc:    "Get gridded population and mortality rates.",
+		resp:   (*rpc.GriddedPopulationResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedPopulationRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.GriddedPopulation(ctx, req.(*rpc.GriddedPopulationRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/population/stream",
+		doc:    "Stream gridded population and mortality rates in chunks, as newline-delimited JSON.",
+		resp:   (*rpc.GriddedPopulationResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.GriddedPopulationRequest) },
+		stream: func(c *CityAQ, req proto.Message, s *restStream) error {
+			return c.StreamGriddedPopulation(req.(*rpc.GriddedPopulationRequest), griddedPopulationRESTStream{s})
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/mapscale",
+		doc:    "Get the range of values for a map.",
+		resp:   (*rpc.MapScaleResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.MapScaleRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.MapScale(ctx, req.(*rpc.MapScaleRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/impacts",
+		doc:    "Summarize the air quality impacts of a source type.",
+		resp:   (*rpc.ImpactSummaryResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.ImpactSummaryRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.ImpactSummary(ctx, req.(*rpc.ImpactSummaryRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/damages",
+		doc:    "Estimate the damages caused by emissions.",
+		resp:   (*rpc.DamagesResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.DamagesRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.Damages(ctx, req.(*rpc.DamagesRequest))
+		},
+	},
+	{
+		method: http.MethodPost, pattern: "cities/{name}/damages",
+		doc:    "Estimate the damages caused by emissions given in the request body.",
+		resp:   (*rpc.DamagesResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.DamagesRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.Damages(ctx, req.(*rpc.DamagesRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cities/{name}/progress",
+		doc:    "Stream the state of a concentration job, as newline-delimited JSON.",
+		resp:   (*rpc.JobProgressResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.JobProgressRequest) },
+		stream: func(c *CityAQ, req proto.Message, s *restStream) error {
+			return c.JobProgress(req.(*rpc.JobProgressRequest), jobProgressRESTStream{s})
+		},
+	},
+	{
+		method: http.MethodPost, pattern: "jobs",
+		doc:    "Start a concentration job.",
+		resp:   (*rpc.Job)(nil),
+		newReq: func() proto.Message { return new(rpc.SubmitConcentrationJobRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.SubmitConcentrationJob(ctx, req.(*rpc.SubmitConcentrationJobRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "jobs",
+		doc:    "List concentration jobs.",
+		resp:   (*rpc.ListJobsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.ListJobsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.ListJobs(ctx, req.(*rpc.ListJobsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "jobs/{id}",
+		doc:    "Get the state of a concentration job.",
+		resp:   (*rpc.Job)(nil),
+		newReq: func() proto.Message { return new(rpc.GetJobRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.GetJob(ctx, req.(*rpc.GetJobRequest))
+		},
+	},
+	{
+		method: http.MethodDelete, pattern: "jobs/{id}",
+		doc:    "Cancel a concentration job.",
+		resp:   (*rpc.Job)(nil),
+		newReq: func() proto.Message { return new(rpc.CancelJobRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.CancelJob(ctx, req.(*rpc.CancelJobRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cache",
+		doc:    "List cached results. Requires administrator access.",
+		resp:   (*rpc.ListCachedResultsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.ListCachedResultsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.ListCachedResults(ctx, req.(*rpc.ListCachedResultsRequest))
+		},
+	},
+	{
+		method: http.MethodDelete, pattern: "cache",
+		doc:    "Delete the cached results that match the query. Requires administrator access.",
+		resp:   (*rpc.InvalidateCachedResultsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.InvalidateCachedResultsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.InvalidateCachedResults(ctx, req.(*rpc.InvalidateCachedResultsRequest))
+		},
+	},
+	{
+		method: http.MethodGet, pattern: "cache/{key}",
+		doc:    "Get information about a cached result. Requires administrator access.",
+		resp:   (*rpc.CachedResultInfo)(nil),
+		newReq: func() proto.Message { return new(rpc.CachedResultRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.CachedResult(ctx, req.(*rpc.CachedResultRequest))
+		},
+	},
+	{
+		method: http.MethodDelete, pattern: "cache/{key}",
+		doc:    "Delete a cached result. Requires administrator access.",
+		resp:   (*rpc.InvalidateCachedResultsResponse)(nil),
+		newReq: func() proto.Message { return new(rpc.InvalidateCachedResultsRequest) },
+		call: func(ctx context.Context, c *CityAQ, req proto.Message) (proto.Message, error) {
+			return c.InvalidateCachedResults(ctx, req.(*rpc.InvalidateCachedResultsRequest))
+		},
+	},
+}
+
+// match returns whether the route matches path, which should not
+// include restPrefix, and the values of any path parameters.
+func (rt restRoute) match(path string) (map[string]string, bool) {
+	pattern := strings.Split(rt.pattern, "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, p := range pattern {
+		if field, ok := restPathParams[p]; ok {
+			params[field] = segments[i]
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// restHandler serves a JSON HTTP API that maps to the CityAQ methods.
+// Request fields are read from path parameters, from query parameters
+// matching the field names, with message and map fields given in
+// their JSON encoding, and, for POST requests, from a JSON request body. Responses are encoded using the protobuf JSON mapping.
+type restHandler struct {
+	c *CityAQ
+}
+
+func (h restHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, restPrefix)
+	if path == openAPIPath && r.Method == http.MethodGet {
+		serveOpenAPI(w)
+		return
+	}
+	var allowed []string
+	for _, rt := range restRoutes {
+		params, ok := rt.match(path)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = append(allowed, rt.method)
+			continue
+		}
+		h.serveRoute(w, r, rt, params)
+		return
+	}
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeRESTError(w, http.StatusMethodNotAllowed, fmt.Errorf("cityaq: method %s not allowed", r.Method))
+		return
+	}
+	writeRESTError(w, http.StatusNotFound, fmt.Errorf("cityaq: %s not found", r.URL.Path))
+}
+
+func (h restHandler) serveRoute(w http.ResponseWriter, r *http.Request, rt restRoute, params map[string]string) {
+	req := rt.newReq()
+	if r.Method == http.MethodPost {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeRESTError(w, http.StatusBadRequest, err)
+			return
+		}
+		if len(b) > 0 {
+			if err := protojson.Unmarshal(b, req); err != nil {
+				writeRESTError(w, http.StatusBadRequest, fmt.Errorf("cityaq: invalid request body: %w", err))
+				return
+			}
+		}
+	}
+	if err := setRESTFields(req.ProtoReflect(), r.URL.Query(), params); err != nil {
+		writeRESTError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Make the authorization header available to methods that
+	// require administrator access.
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", auth))
+	}
+
+	if rt.stream != nil {
+		s := &restStream{ctx: ctx, w: w}
+		if err := rt.stream(h.c, req, s); err != nil {
+			if !s.started {
+				writeRESTError(w, httpStatus(err), err)
+				return
+			}
+			// The status has already been sent, so
+			// report the error in the stream instead.
+			json.NewEncoder(w).Encode(newRESTError(err))
+		}
+		return
+	}
+
+	resp, err := rt.call(ctx, h.c, req)
+	if err != nil {
+		writeRESTError(w, httpStatus(err), err)
+		return
+	}
+	b, err := protojson.Marshal(resp)
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+// setRESTFields sets the fields of m from the given query and
+// path parameters.
+func setRESTFields(m protoreflect.Message, query url.Values, params map[string]string) error {
+	fields := m.Descriptor().Fields()
+	field := func(name string) protoreflect.FieldDescriptor {
+		if alias, ok := restQueryAliases[strings.ToLower(name)]; ok {
+			name = alias
+		}
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if strings.EqualFold(string(fd.Name()), name) || strings.EqualFold(fd.JSONName(), name) {
+				return fd
+			}
+		}
+		return nil
+	}
+	for name, values := range query {
+		fd := field(name)
+		if fd == nil {
+			return fmt.Errorf("cityaq: invalid query parameter %q", name)
+		}
+		for _, v := range values {
+			if err := setRESTField(m, fd, v); err != nil {
+				return fmt.Errorf("cityaq: invalid query parameter %q: %w", name, err)
+			}
+		}
+	}
+	for name, v := range params {
+		if err := setRESTField(m, field(name), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setRESTField sets field fd of m to the value represented by s, or
+// appends it if the field is a list. Message and map fields are
+// represented by their protobuf JSON encoding.
+func setRESTField(m protoreflect.Message, fd protoreflect.FieldDescriptor, s string) error {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return setRESTMessageField(m, fd, s)
+	}
+	var v protoreflect.Value
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		v = protoreflect.ValueOfString(s)
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v = protoreflect.ValueOfBool(b)
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v = protoreflect.ValueOfFloat64(f)
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		v = protoreflect.ValueOfFloat32(float32(f))
+	case protoreflect.Int32Kind:
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		v = protoreflect.ValueOfInt32(int32(i))
+	case protoreflect.Int64Kind:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v = protoreflect.ValueOfInt64(i)
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			v = protoreflect.ValueOfEnum(ev.Number())
+			break
+		}
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(i)) == nil {
+			return fmt.Errorf("invalid %s value %q", fd.Enum().Name(), s)
+		}
+		v = protoreflect.ValueOfEnum(protoreflect.EnumNumber(i))
+	default:
+		return fmt.Errorf("%s must be specified in a JSON request body", fd.Name())
+	}
+	if fd.IsList() {
+		m.Mutable(fd).List().Append(v)
+	} else {
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+// setRESTMessageField sets message or map field fd of m from its
+// protobuf JSON encoding in s. Elements of list fields are appended,
+// and entries of map fields are added, to those already set.
+func setRESTMessageField(m protoreflect.Message, fd protoreflect.FieldDescriptor, s string) error {
+	tmp := m.New()
+	b := fmt.Sprintf("{%q:%s}", fd.JSONName(), s)
+	if err := protojson.Unmarshal([]byte(b), tmp.Interface()); err != nil {
+		return fmt.Errorf("invalid JSON value for %s: %w", fd.Name(), err)
+	}
+	switch {
+	case fd.IsList():
+		l, tl := m.Mutable(fd).List(), tmp.Get(fd).List()
+		for i := 0; i < tl.Len(); i++ {
+			l.Append(tl.Get(i))
+		}
+	case fd.IsMap():
+		mm := m.Mutable(fd).Map()
+		tmp.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			mm.Set(k, v)
+			return true
+		})
+	default:
+		m.Set(fd, tmp.Get(fd))
+	}
+	return nil
+}
+
+// restError is the body of an error response.
+type restError struct {
+	Error string `json:"error"`
+
+	// Code is the name of the gRPC status code of the error, if any.
+	Code string `json:"code,omitempty"`
+
+	// Details holds the errdetails messages attached to the error,
+	// in the protobuf JSON format.
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// newRESTError returns the body of the error response for err.
+func newRESTError(err error) restError {
+	st, ok := grpcStatus(err)
+	if !ok {
+		return restError{Error: err.Error()}
+	}
+	e := restError{Error: st.Message(), Code: st.Code().String()}
+	for _, d := range st.Proto().GetDetails() {
+		if b, err := protojson.Marshal(d); err == nil {
+			e.Details = append(e.Details, b)
+		}
+	}
+	return e
+}
+
+func writeRESTError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(newRESTError(err))
+}
+
+// httpStatus returns the HTTP status code corresponding to err.
+func httpStatus(err error) int {
+	switch status.Code(toStatusError(err)) {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// restStream sends the messages from a streaming method as
+// newline-delimited JSON.
+type restStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *restStream) Context() context.Context { return s.ctx }
+
+func (s *restStream) send(m proto.Message) error {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.started = true
+	}
+	if _, err := s.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+type griddedEmissionsRESTStream struct{ *restStream }
+
+func (s griddedEmissionsRESTStream) Send(m *rpc.GriddedEmissionsResponse) error { return s.send(m) }
+
+type griddedConcentrationsRESTStream struct{ *restStream }
+
+func (s griddedConcentrationsRESTStream) Send(m *rpc.GriddedConcentrationsResponse) error {
+	return s.send(m)
+}
+
+type griddedPopulationRESTStream struct{ *restStream }
+
+func (s griddedPopulationRESTStream) Send(m *rpc.GriddedPopulationResponse) error { return s.send(m) }
+
+type jobProgressRESTStream struct{ *restStream }
+
+func (s jobProgressRESTStream) Send(m *rpc.JobProgressResponse) error { return s.send(m) }
@@ -0,0 +1,103 @@
+package cityaq
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestRESTRoutes(t *testing.T) {
+	for _, rt := range restRoutes {
+		if (rt.call == nil) == (rt.stream == nil) {
+			t.Errorf("%s %s: exactly one of call and stream must be set", rt.method, rt.pattern)
+		}
+		fields := rt.newReq().ProtoReflect().Descriptor().Fields()
+		for _, p := range strings.Split(rt.pattern, "/") {
+			if name, ok := restPathParams[p]; ok && fields.ByName(protoreflect.Name(name)) == nil {
+				t.Errorf("%s %s: request has no field %s", rt.method, rt.pattern, name)
+			}
+		}
+	}
+}
+
+func TestSetRESTFields(t *testing.T) {
+	req := new(rpc.ImpactSummaryRequest)
+	err := setRESTFields(req.ProtoReflect(), url.Values{
+		"source":   {"roadways"},
+		"emission": {"PM2_5"},
+	}, map[string]string{"CityName": "Accra Metropolitan"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.CityName != "Accra Metropolitan" || req.SourceType != "roadways" || req.Emission != rpc.Emission_PM2_5 {
+		t.Errorf("wrong request: %v", req)
+	}
+
+	req = new(rpc.ImpactSummaryRequest)
+	err = setRESTFields(req.ProtoReflect(), url.Values{
+		"scenario": {`{"Emissions":[{"Emission":"PM2_5","Amount":500,"Unit":"t/yr"}]}`},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := req.GetScenario().GetEmissions(); len(e) != 1 || e[0].Emission != rpc.Emission_PM2_5 || e[0].Amount != 500 || e[0].Unit != "t/yr" {
+		t.Errorf("wrong scenario: %v", req.Scenario)
+	}
+
+	for _, q := range []url.Values{
+		{"emission": {"PM3"}},
+		{"nonexistent": {"x"}},
+		{"scenario": {"PM2_5:500:t/yr"}},
+	} {
+		if err := setRESTFields(new(rpc.ImpactSummaryRequest).ProtoReflect(), q, nil); err == nil {
+			t.Errorf("%v: expected an error", q)
+		}
+	}
+}
+
+func TestRESTHandler(t *testing.T) {
+	c := &CityAQ{CityGeomDir: "testdata/cities"}
+	h := restHandler{c: c}
+	for _, test := range []struct {
+		method, path string
+		code         int
+	}{
+		{method: http.MethodGet, path: "/api/v1/cities", code: http.StatusOK},
+		{method: http.MethodGet, path: "/api/v1/cities/Accra%20Metropolitan/geometry", code: http.StatusOK},
+		{method: http.MethodPut, path: "/api/v1/cities", code: http.StatusMethodNotAllowed},
+		{method: http.MethodGet, path: "/api/v1/nowhere", code: http.StatusNotFound},
+		{method: http.MethodGet, path: "/api/v1/cities?color=blue", code: http.StatusBadRequest},
+		{method: http.MethodGet, path: "/api/v1/cache", code: http.StatusForbidden},
+	} {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(test.method, test.path, nil))
+			if w.Code != test.code {
+				t.Fatalf("status %d != %d: %s", w.Code, test.code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				var e restError
+				if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil || e.Error == "" {
+					t.Errorf("invalid error response %q", w.Body.String())
+				}
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/cities", nil))
+	cities := new(rpc.CitiesResponse)
+	if err := protojson.Unmarshal(w.Body.Bytes(), cities); err != nil {
+		t.Fatal(err)
+	}
+	if len(cities.Names) != 2 {
+		t.Errorf("have %d cities, want 2", len(cities.Names))
+	}
+}
@@ -0,0 +1,265 @@
+package cityaq
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/ctessum/requestcache/v3"
+)
+
+// S3Config specifies how to access S3-compatible object storage.
+type S3Config struct {
+	// Endpoint is the URL of the storage service, for example
+	// "http://localhost:9000" for a local MinIO server. If it is
+	// empty, AWS S3 is used.
+	Endpoint string
+
+	// Region is the storage region. The default is "us-east-1".
+	Region string
+
+	// AccessKeyID and SecretAccessKey are the storage credentials.
+	// If they are empty, credentials are read from the standard AWS
+	// environment variables and configuration files.
+	AccessKeyID, SecretAccessKey string
+}
+
+// setCloudEnv sets the AWS environment variables from which the InMAP
+// cloud client reads its S3 region and credentials to the values in
+// the receiver, if specified. The cloud client always uses the AWS S3
+// endpoint, so an error is returned if a different endpoint, such as
+// that of a MinIO server, is specified.
+func (cfg S3Config) setCloudEnv() error {
+	if cfg.Endpoint != "" {
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil {
+			return fmt.Errorf("cityaq: invalid S3 endpoint: %w", err)
+		}
+		if h := u.Hostname(); h != "amazonaws.com" && !strings.HasSuffix(h, ".amazonaws.com") {
+			return fmt.Errorf("cityaq: the %s backend can only store results in AWS S3, not at the S3 endpoint %s", CloudBackend, cfg.Endpoint)
+		}
+	}
+	for _, v := range []struct{ name, value string }{
+		{"AWS_REGION", cfg.Region},
+		{"AWS_ACCESS_KEY_ID", cfg.AccessKeyID},
+		{"AWS_SECRET_ACCESS_KEY", cfg.SecretAccessKey},
+	} {
+		if v.value == "" {
+			continue
+		}
+		if err := os.Setenv(v.name, v.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resultStore is a cacheStore that also stores the results
+// themselves, for storage locations that are not supported
+// by requestcache.
+type resultStore interface {
+	cacheStore
+
+	// read reads the result with the given key into result and
+	// returns whether it was found.
+	read(ctx context.Context, key string, result requestcache.Result) (bool, error)
+
+	// write stores result under the given key.
+	write(ctx context.Context, key string, result requestcache.Result) error
+}
+
+// storedJob wraps a job so that its result is retrieved from a
+// resultStore if it is there, and saved to the store after
+// the job is run otherwise.
+type storedJob struct {
+	requestcache.Job
+	store resultStore
+}
+
+func (j storedJob) Run(ctx context.Context, result requestcache.Result) error {
+	ok, err := j.store.read(ctx, j.Key(), result)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	if err := j.Job.Run(ctx, result); err != nil {
+		return err
+	}
+	return j.store.write(ctx, j.Key(), result)
+}
+
+// storedJob returns job wrapped so that its result is saved in the
+// location specified by CacheLoc, if that location is not supported
+// by requestcache. Otherwise it returns job unchanged.
+func (c *CityAQ) storedJob(job requestcache.Job) requestcache.Job {
+	if s, ok := c.store.(resultStore); ok {
+		return storedJob{Job: job, store: s}
+	}
+	return job
+}
+
+// s3Store holds results in an S3-compatible storage bucket.
+type s3Store struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+// newS3Store returns a store for the s3://bucket/prefix URL loc.
+func newS3Store(loc *url.URL, cfg S3Config) (*s3Store, error) {
+	awsCfg := &aws.Config{
+		Region: aws.String(cfg.Region),
+	}
+	if cfg.Region == "" {
+		awsCfg.Region = aws.String("us-east-1")
+	}
+	if cfg.Endpoint != "" {
+		// S3-compatible services such as MinIO usually don't
+		// support virtual-host-style bucket addressing.
+		awsCfg.Endpoint = aws.String(cfg.Endpoint)
+		awsCfg.S3ForcePathStyle = aws.Bool(true)
+	}
+	if cfg.AccessKeyID != "" || cfg.SecretAccessKey != "" {
+		awsCfg.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("cityaq: connecting to S3: %w", err)
+	}
+	return &s3Store{
+		client: s3.New(sess),
+		bucket: loc.Host,
+		prefix: strings.Trim(loc.Path, "/"),
+	}, nil
+}
+
+// object returns the name of the object holding the file with the
+// given key and extension.
+func (s *s3Store) object(key, ext string) *string {
+	return aws.String(path.Join(s.prefix, key+ext))
+}
+
+// isNotFound returns whether err indicates that an object
+// does not exist.
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound"
+	}
+	return false
+}
+
+func (s *s3Store) get(ctx context.Context, key, ext string) ([]byte, error) {
+	o, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.object(key, ext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer o.Body.Close()
+	return ioutil.ReadAll(o.Body)
+}
+
+func (s *s3Store) put(ctx context.Context, key, ext string, b []byte) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.object(key, ext),
+		Body:   bytes.NewReader(b),
+	})
+	return err
+}
+
+func (s *s3Store) read(ctx context.Context, key string, result requestcache.Result) (bool, error) {
+	b, err := s.get(ctx, key, requestcache.FileExtension)
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("cityaq: reading cached result %s: %w", key, err)
+	}
+	if err := result.UnmarshalBinary(b); err != nil {
+		return false, fmt.Errorf("cityaq: reading cached result %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (s *s3Store) write(ctx context.Context, key string, result requestcache.Result) error {
+	b, err := result.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if err := s.put(ctx, key, requestcache.FileExtension, b); err != nil {
+		return fmt.Errorf("cityaq: caching result %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *s3Store) list(ctx context.Context) ([]cacheMeta, error) {
+	var prefix string
+	if s.prefix != "" {
+		prefix = s.prefix + "/"
+	}
+	var objects []*s3.Object
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		objects = append(objects, page.Contents...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cityaq: listing cache: %w", err)
+	}
+	var o []cacheMeta
+	for _, obj := range objects {
+		name := aws.StringValue(obj.Key)
+		if !strings.HasSuffix(name, requestcache.FileExtension) {
+			continue
+		}
+		key := strings.TrimSuffix(path.Base(name), requestcache.FileExtension)
+		m := cacheMeta{Key: key, Created: aws.TimeValue(obj.LastModified)}
+		b, err := s.get(ctx, key, metaExtension)
+		if err == nil {
+			if err := m.unmarshal(b); err != nil {
+				return nil, fmt.Errorf("cityaq: reading cache information for %s: %w", key, err)
+			}
+		} else if !isNotFound(err) {
+			return nil, fmt.Errorf("cityaq: reading cache information for %s: %w", key, err)
+		}
+		m.Size = aws.Int64Value(obj.Size)
+		o = append(o, m)
+	}
+	return o, nil
+}
+
+func (s *s3Store) writeMeta(ctx context.Context, m cacheMeta, _ *inmapResult) error {
+	b, err := m.marshal()
+	if err != nil {
+		return err
+	}
+	return s.put(ctx, m.Key, metaExtension, b)
+}
+
+func (s *s3Store) remove(ctx context.Context, key string) error {
+	for _, ext := range []string{requestcache.FileExtension, metaExtension} {
+		_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    s.object(key, ext),
+		})
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("cityaq: removing cached result: %w", err)
+		}
+	}
+	return nil
+}
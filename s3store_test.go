@@ -0,0 +1,166 @@
+package cityaq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ctessum/requestcache/v3"
+)
+
+// fakeS3 is a minimal in-memory stand-in for an S3-compatible
+// storage service, using path-style addressing.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		prefix := strings.TrimSuffix(name, "/") + "/" + r.URL.Query().Get("prefix")
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated>`)
+		for _, k := range keys {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><LastModified>2020-01-01T00:00:00.000Z</LastModified></Contents>`,
+				strings.SplitN(k, "/", 2)[1], len(f.objects[k]))
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	case r.Method == http.MethodGet:
+		b, ok := f.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		w.Write(b)
+	case r.Method == http.MethodPut:
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		f.objects[name] = b
+	case r.Method == http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// countingJob counts how many times it has been run.
+type countingJob struct {
+	key  string
+	runs int
+}
+
+func (j *countingJob) Key() string { return j.key }
+
+func (j *countingJob) Run(ctx context.Context, result requestcache.Result) error {
+	j.runs++
+	r := result.(*inmapResult)
+	r.PrimaryPM25 = []float64{1, 2, 3}
+	return nil
+}
+
+func TestS3Store(t *testing.T) {
+	s3 := &fakeS3{objects: make(map[string][]byte)}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+
+	c := &CityAQ{
+		CacheLoc: "s3://bucket/results",
+		S3: S3Config{
+			Endpoint:        srv.URL,
+			AccessKeyID:     "id",
+			SecretAccessKey: "secret",
+		},
+	}
+	if err := c.setupCache(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	job := &countingJob{key: "concentrationa"}
+	for i := 0; i < 2; i++ {
+		result := new(inmapResult)
+		if err := c.storedJob(job).Run(ctx, result); err != nil {
+			t.Fatal(err)
+		}
+		if len(result.PrimaryPM25) != 3 || result.PrimaryPM25[2] != 3 {
+			t.Fatalf("wrong result: %v", result.PrimaryPM25)
+		}
+	}
+	if job.runs != 1 {
+		t.Errorf("job was run %d times; should have been run once", job.runs)
+	}
+	if _, ok := s3.objects["bucket/results/concentrationa.dat"]; !ok {
+		t.Error("result was not stored")
+	}
+
+	if err := c.store.writeMeta(ctx, cacheMeta{Key: "concentrationa", CityName: "Karachi"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	list, err := c.cachedResults(ctx, cacheMeta{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Key != "concentrationa" || list[0].CityName != "Karachi" || list[0].Size == 0 {
+		t.Errorf("wrong list: %+v", list)
+	}
+
+	keys, err := c.invalidateCachedResults(ctx, cacheMeta{CityName: "Karachi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || len(s3.objects) != 0 {
+		t.Errorf("results were not removed: %v, %v", keys, s3.objects)
+	}
+}
+
+func TestS3Config_setCloudEnv(t *testing.T) {
+	for _, name := range []string{"AWS_REGION", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"} {
+		if v, ok := os.LookupEnv(name); ok {
+			defer os.Setenv(name, v)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+
+	if err := (S3Config{Endpoint: "http://localhost:9000"}).setCloudEnv(); err == nil {
+		t.Error("expected an error for a non-AWS endpoint")
+	}
+	cfg := S3Config{
+		Endpoint:        "https://s3.us-west-2.amazonaws.com",
+		Region:          "us-west-2",
+		AccessKeyID:     "id",
+		SecretAccessKey: "secret",
+	}
+	if err := cfg.setCloudEnv(); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"AWS_REGION":            "us-west-2",
+		"AWS_ACCESS_KEY_ID":     "id",
+		"AWS_SECRET_ACCESS_KEY": "secret",
+	} {
+		if have := os.Getenv(name); have != want {
+			t.Errorf("%s: have %q, want %q", name, have, want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cityaq
+
+import (
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+)
+
+// gridChunkSize is the number of grid cells sent in each message
+// by the streaming gridded RPCs.
+const gridChunkSize = 10000
+
+// chunks calls f with the beginning and end indices of each chunk of
+// n grid cells. f is always called at least once.
+func chunks(n int, f func(begin, end int) error) error {
+	for begin := 0; begin == 0 || begin < n; begin += gridChunkSize {
+		end := begin + gridChunkSize
+		if end > n {
+			end = n
+		}
+		if err := f(begin, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The functions below return the chunk of a slice between begin
+// and end, or nil if the slice is empty.
+
+func polygonChunk(v []*rpc.Polygon, begin, end int) []*rpc.Polygon {
+	if len(v) == 0 {
+		return nil
+	}
+	return v[begin:end]
+}
+
+func float64Chunk(v []float64, begin, end int) []float64 {
+	if len(v) == 0 {
+		return nil
+	}
+	return v[begin:end]
+}
+
+func float32Chunk(v []float32, begin, end int) []float32 {
+	if len(v) == 0 {
+		return nil
+	}
+	return v[begin:end]
+}
+
+// gridChunk returns the part of g that describes the grid cells
+// between begin and end. A regular grid is only described in the
+// first chunk, and the bounds of the cells of an irregular grid are
+// split among the chunks.
+func gridChunk(g *rpc.CompactGrid, begin, end int) *rpc.CompactGrid {
+	switch {
+	case g == nil:
+		return nil
+	case g.Nx > 0:
+		if begin == 0 {
+			return g
+		}
+		return nil
+	case len(g.CellBounds) == 0:
+		return g
+	default:
+		return &rpc.CompactGrid{CellBounds: g.CellBounds[4*begin : 4*end]}
+	}
+}
+
+// gridCells returns the number of grid cells in a gridded response.
+func gridCells(polygons []*rpc.Polygon, values []float32) int {
+	if len(polygons) > 0 {
+		return len(polygons)
+	}
+	return len(values)
+}
+
+// StreamGriddedEmissions sends the response to GriddedEmissions
+// in chunks.
+func (c *CityAQ) StreamGriddedEmissions(req *rpc.GriddedEmissionsRequest, stream rpc.CityAQ_StreamGriddedEmissionsServer) error {
+	o, err := c.GriddedEmissions(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return chunks(gridCells(o.Polygons, o.Values), func(begin, end int) error {
+		return stream.Send(&rpc.GriddedEmissionsResponse{
+			Polygons:  polygonChunk(o.Polygons, begin, end),
+			Grid:      gridChunk(o.Grid, begin, end),
+			Emissions: float64Chunk(o.Emissions, begin, end),
+			Values:    float32Chunk(o.Values, begin, end),
+		})
+	})
+}
+
+// StreamGriddedConcentrations sends the response to
+// GriddedConcentrations in chunks.
+func (c *CityAQ) StreamGriddedConcentrations(req *rpc.GriddedConcentrationsRequest, stream rpc.CityAQ_StreamGriddedConcentrationsServer) error {
+	o, err := c.GriddedConcentrations(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return chunks(gridCells(o.Polygons, o.Values), func(begin, end int) error {
+		return stream.Send(&rpc.GriddedConcentrationsResponse{
+			Polygons:          polygonChunk(o.Polygons, begin, end),
+			Grid:              gridChunk(o.Grid, begin, end),
+			Concentrations:    float64Chunk(o.Concentrations, begin, end),
+			PrimaryPM25:       float64Chunk(o.PrimaryPM25, begin, end),
+			PNH4:              float64Chunk(o.PNH4, begin, end),
+			PNO3:              float64Chunk(o.PNO3, begin, end),
+			PSO4:              float64Chunk(o.PSO4, begin, end),
+			SOA:               float64Chunk(o.SOA, begin, end),
+			BaselineTotalPM25: float64Chunk(o.BaselineTotalPM25, begin, end),
+			Values:            float32Chunk(o.Values, begin, end),
+
+			PrimaryPM25Values:       float32Chunk(o.PrimaryPM25Values, begin, end),
+			PNH4Values:              float32Chunk(o.PNH4Values, begin, end),
+			PNO3Values:              float32Chunk(o.PNO3Values, begin, end),
+			PSO4Values:              float32Chunk(o.PSO4Values, begin, end),
+			SOAValues:               float32Chunk(o.SOAValues, begin, end),
+			BaselineTotalPM25Values: float32Chunk(o.BaselineTotalPM25Values, begin, end),
+		})
+	})
+}
+
+// StreamGriddedPopulation sends the response to GriddedPopulation
+// in chunks.
+func (c *CityAQ) StreamGriddedPopulation(req *rpc.GriddedPopulationRequest, stream rpc.CityAQ_StreamGriddedPopulationServer) error {
+	o, err := c.GriddedPopulation(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return chunks(gridCells(o.Polygons, o.Values), func(begin, end int) error {
+		return stream.Send(&rpc.GriddedPopulationResponse{
+			Polygons:      polygonChunk(o.Polygons, begin, end),
+			Grid:          gridChunk(o.Grid, begin, end),
+			Population:    float64Chunk(o.Population, begin, end),
+			MortalityRate: float64Chunk(o.MortalityRate, begin, end),
+			Values:        float32Chunk(o.Values, begin, end),
+
+			MortalityRateValues: float32Chunk(o.MortalityRateValues, begin, end),
+		})
+	})
+}
@@ -0,0 +1,147 @@
+package cityaq
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/ctessum/cityaq/cityaqrpc"
+	"github.com/ctessum/geom"
+	"github.com/spatialmodel/inmap/emissions/aep/aeputil"
+	"github.com/spatialmodel/inmap/inmaputil"
+	"google.golang.org/grpc"
+)
+
+// gridModel is a concentrationModel that returns a result with
+// n grid cells in a row. If irregular is true, the cells are not
+// all the same height.
+type gridModel struct {
+	n         int
+	irregular bool
+}
+
+func (m gridModel) run(ctx context.Context, name string, cfg *inmaputil.Cfg, result *inmapResult, progress progressFunc) error {
+	for i := 0; i < m.n; i++ {
+		x, y := float64(i), 1.0
+		if m.irregular && i%2 == 1 {
+			y = 2
+		}
+		result.Grid = append(result.Grid, geom.Polygon{{{X: x, Y: 0}, {X: x + 1, Y: 0}, {X: x + 1, Y: y}, {X: x, Y: y}}})
+		result.Population = append(result.Population, x)
+		result.MortalityRate = append(result.MortalityRate, 1)
+	}
+	return nil
+}
+
+type fakeGriddedPopulationServer struct {
+	grpc.ServerStream
+	msgs []*rpc.GriddedPopulationResponse
+}
+
+func (s *fakeGriddedPopulationServer) Send(r *rpc.GriddedPopulationResponse) error {
+	s.msgs = append(s.msgs, r)
+	return nil
+}
+
+func (s *fakeGriddedPopulationServer) Context() context.Context { return context.Background() }
+
+func TestCityAQ_StreamGriddedPopulation(t *testing.T) {
+	const n = 2*gridChunkSize + 1
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           gridModel{n: n},
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+
+	for _, encoding := range []rpc.GridEncoding{rpc.GridEncoding_PolygonEncoding, rpc.GridEncoding_CompactEncoding} {
+		t.Run(encoding.String(), func(t *testing.T) {
+			s := new(fakeGriddedPopulationServer)
+			err := c.StreamGriddedPopulation(&rpc.GriddedPopulationRequest{
+				CityName:   "Accra Metropolitan",
+				SourceType: "electric_gen_egugrid",
+				Encoding:   encoding,
+			}, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(s.msgs) != 3 {
+				t.Fatalf("have %d messages, want 3", len(s.msgs))
+			}
+			var cells, pop int
+			for i, m := range s.msgs {
+				cells += len(m.Polygons) + len(m.Values)
+				pop += len(m.Population) + len(m.Values)
+				if len(m.MortalityRate)+len(m.MortalityRateValues) != len(m.Polygons)+len(m.Values) {
+					t.Errorf("message %d: mortality rate length %d", i, len(m.MortalityRate)+len(m.MortalityRateValues))
+				}
+				if encoding == rpc.GridEncoding_CompactEncoding && len(m.MortalityRate) != 0 {
+					t.Errorf("message %d: double-precision mortality rates in compact encoding", i)
+				}
+				if (m.Grid != nil) != (i == 0 && encoding == rpc.GridEncoding_CompactEncoding) {
+					t.Errorf("message %d: unexpected grid %v", i, m.Grid)
+				}
+			}
+			if cells != n || pop != n {
+				t.Errorf("have %d cells and %d values, want %d", cells, pop, n)
+			}
+			if encoding == rpc.GridEncoding_CompactEncoding && s.msgs[0].Grid.Nx != n {
+				t.Errorf("nx: have %d, want %d", s.msgs[0].Grid.Nx, n)
+			}
+		})
+	}
+}
+
+func TestCityAQ_StreamGriddedPopulation_irregular(t *testing.T) {
+	const n = 2*gridChunkSize + 1
+	c := &CityAQ{
+		CityGeomDir: "testdata/cities",
+		SpatialConfig: aeputil.SpatialConfig{
+			SrgSpecOSM:            "testdata/srgspec_osm.json",
+			SrgSpecSMOKE:          "testdata/srgspec_smoke.csv",
+			SrgShapefileDirectory: "testdata",
+			SCCExactMatch:         true,
+			GridRef:               []string{"testdata/gridref.txt"},
+			OutputSR:              "+proj=longlat",
+			InputSR:               "+proj=longlat",
+		},
+		InMAPConfigFile: "testdata/inmap_config.toml",
+		model:           gridModel{n: n, irregular: true},
+	}
+	c.modelSetupOnce.Do(func() error { return nil })
+
+	s := new(fakeGriddedPopulationServer)
+	err := c.StreamGriddedPopulation(&rpc.GriddedPopulationRequest{
+		CityName:   "Accra Metropolitan",
+		SourceType: "electric_gen_egugrid",
+		Encoding:   rpc.GridEncoding_CompactEncoding,
+	}, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.msgs) != 3 {
+		t.Fatalf("have %d messages, want 3", len(s.msgs))
+	}
+	var cells int
+	for i, m := range s.msgs {
+		if m.Grid == nil || m.Grid.Nx != 0 || len(m.Grid.CellBounds) != 4*len(m.Values) {
+			t.Fatalf("message %d: grid doesn't match %d values: %v", i, len(m.Values), m.Grid)
+		}
+		// The first cell in each message starts at x = its index.
+		if x := m.Grid.CellBounds[0]; x != float64(cells) {
+			t.Errorf("message %d: first cell at x=%g, want %d", i, x, cells)
+		}
+		cells += len(m.Values)
+	}
+	if cells != n {
+		t.Errorf("have %d cells, want %d", cells, n)
+	}
+}